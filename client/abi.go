@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ango-ya/chain-client/contract"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnexpectedOutput is returned when the output of a call does not decode with the ABI of the method,
+	// typically because the address is not the expected contract.
+	ErrUnexpectedOutput = errors.New("unexpected output")
+)
+
+// pack encodes the call of method, the constructor when method is empty.
+func pack(contractABI *abi.ABI, method string, args ...interface{}) ([]byte, error) {
+	input, err := contractABI.Pack(method, args...)
+	if err != nil {
+		if method == "" {
+			method = "constructor"
+		}
+		return nil, errors.Wrapf(err, "failed to pack %s", method)
+	}
+	return input, nil
+}
+
+// unpack decodes the single output of method into out, which points to a value of the matching type.
+func unpack(contractABI *abi.ABI, method string, output []byte, out interface{}) error {
+	if len(output) == 0 {
+		return errors.Wrapf(ErrUnexpectedOutput, "%s returned nothing", method)
+	}
+
+	if err := contractABI.UnpackIntoInterface(out, method, output); err != nil {
+		return errors.Wrapf(ErrUnexpectedOutput, "failed to unpack %s: %s", method, err.Error())
+	}
+	return nil
+}
+
+// query calls the view method of the contract at block, then unpacks its output into out.
+func (c *BlockchainClient) query(ctx context.Context, contractABI *contract.ParsedABI, to common.Address, block *big.Int, out interface{}, method string, args ...interface{}) error {
+	input, err := pack(&contractABI.ABI, method, args...)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.callContract(ctx, to, input, block)
+	if err != nil {
+		return errors.Wrapf(err, "failed to query contract(=%s), input(=%v)", to.String(), input)
+	}
+
+	return errors.Wrapf(unpack(&contractABI.ABI, method, output, out), "contract(=%s)", to.String())
+}
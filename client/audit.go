@@ -0,0 +1,240 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	Redacted = "[redacted]"
+
+	auditTimeout = 5 * time.Second
+)
+
+var (
+	ErrAuditTampered = errors.New("audit log tampered")
+)
+
+// AuditRecord describes a privileged operation, i.e. every method signing a transaction.
+// Rejected requests are recorded as well, with the validation outcome.
+type AuditRecord struct {
+	Time        time.Time         `json:"time"`
+	Operator    string            `json:"operator"`
+	Method      string            `json:"method"`
+	RequestType string            `json:"request_type"`
+	Params      map[string]string `json:"params"`
+	TxHash      string            `json:"tx_hash,omitempty"`
+	Async       bool              `json:"async"`
+	Outcome     string            `json:"outcome"`
+	Error       string            `json:"error,omitempty"`
+}
+
+// AuditSink receives the audit records. Record is called synchronously at the end of the method,
+// a failure is logged but does not change the result, as the transaction may already be sent.
+type AuditSink interface {
+	Record(ctx context.Context, r AuditRecord) error
+}
+
+// auditRecord builds the record of the call, ok is false when the request does not sign anything.
+func auditRecord(cl *call, err error) (r AuditRecord, ok bool) {
+	signer, ok := cl.req.(interface{ GetPrivateKey() string })
+	if !ok {
+		return
+	}
+
+	r = AuditRecord{
+		Time:        time.Now().UTC(),
+		Method:      cl.method,
+		RequestType: cl.typ.String(),
+		Params:      auditParams(cl.req),
+		TxHash:      cl.hash,
+		Async:       cl.async,
+		Outcome:     OutcomeOK,
+	}
+	if key, kerr := crypto.HexToECDSA(signer.GetPrivateKey()); kerr == nil {
+		r.Operator = crypto.PubkeyToAddress(key.PublicKey).String()
+	}
+
+	switch {
+	case err != nil:
+		r.Outcome = ErrorCategory(err)
+		r.Error = err.Error()
+	case cl.async:
+		r.Outcome = OutcomeSent
+	}
+	return
+}
+
+// auditParams flattens the request into strings, redacting private keys.
+func auditParams(req interface{}) map[string]string {
+	params := make(map[string]string)
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		return params
+	}
+
+	dec := json.NewDecoder(strings.NewReader(string(b)))
+	dec.UseNumber()
+	var fields map[string]interface{}
+	if err = dec.Decode(&fields); err != nil {
+		return params
+	}
+
+	for k, v := range fields {
+		if strings.Contains(k, "private") {
+			params[k] = Redacted
+			continue
+		}
+		params[k] = fmt.Sprint(v)
+	}
+	return params
+}
+
+func (c *BlockchainClient) audit(cl *call, err error) {
+	r, ok := auditRecord(cl, err)
+	if !ok {
+		return
+	}
+
+	// the call context is often done when the call failed, so the record gets its own deadline, keeping the span
+	ctx, cancel := context.WithTimeout(trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(cl.ctx)), auditTimeout)
+	defer cancel()
+
+	if aerr := c.auditSink.Record(ctx, r); aerr != nil {
+		c.logger.Error().Err(aerr).Msgf("failed to record audit of %s, hash=%s", cl.method, cl.hash)
+	}
+}
+
+// auditEntry is a line of the audit log. Hash covers the entry without it, PrevHash included,
+// so that altering, inserting or removing a line breaks the chain.
+type auditEntry struct {
+	Seq uint64 `json:"seq"`
+	AuditRecord
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash,omitempty"`
+}
+
+func (e auditEntry) digest() (string, error) {
+	e.Hash = ""
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal audit entry")
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// FileAuditSink appends hash chained records to a JSONL file.
+type FileAuditSink struct {
+	sync.Mutex
+
+	file *os.File
+	seq  uint64
+	head string
+}
+
+var _ AuditSink = (*FileAuditSink)(nil)
+
+// NewFileAuditSink opens the audit log, verifying the entries already written before continuing the chain.
+func NewFileAuditSink(path string) (s *FileAuditSink, err error) {
+	s = &FileAuditSink{}
+
+	if s.seq, s.head, err = VerifyAuditLog(path); err != nil && !os.IsNotExist(errors.Cause(err)) {
+		return nil, err
+	}
+
+	if s.file, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600); err != nil {
+		return nil, errors.Wrapf(err, "failed to open audit log(=%s)", path)
+	}
+	return s, nil
+}
+
+func (s *FileAuditSink) Record(ctx context.Context, r AuditRecord) (err error) {
+	s.Lock()
+	defer s.Unlock()
+
+	e := auditEntry{Seq: s.seq + 1, AuditRecord: r, PrevHash: s.head}
+	if e.Hash, err = e.digest(); err != nil {
+		return
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal audit entry")
+	}
+	if _, err = s.file.Write(append(b, '\n')); err != nil {
+		return errors.Wrap(err, "failed to write audit entry")
+	}
+	if err = s.file.Sync(); err != nil {
+		return errors.Wrap(err, "failed to sync audit log")
+	}
+
+	s.seq, s.head = e.Seq, e.Hash
+	return
+}
+
+// Head returns the hash of the last entry. Keeping it elsewhere allows to detect the truncation of the log.
+func (s *FileAuditSink) Head() string {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.head
+}
+
+func (s *FileAuditSink) Close() error {
+	return s.file.Close()
+}
+
+// VerifyAuditLog checks the hash chain of the audit log and returns the number of entries and the hash of the last one.
+// ErrAuditTampered is returned, with the line, when an entry was modified, inserted or removed.
+func VerifyAuditLog(path string) (count uint64, head string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to open audit log(=%s)", path)
+		return
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var e auditEntry
+		if err = json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			err = errors.Wrapf(ErrAuditTampered, "malformed entry at line %d: %s", line, err)
+			return
+		}
+
+		if e.Seq != count+1 || e.PrevHash != head {
+			err = errors.Wrapf(ErrAuditTampered, "broken chain at line %d", line)
+			return
+		}
+
+		var digest string
+		if digest, err = e.digest(); err != nil {
+			return
+		}
+		if digest != e.Hash {
+			err = errors.Wrapf(ErrAuditTampered, "hash mismatch at line %d", line)
+			return
+		}
+
+		count, head = e.Seq, e.Hash
+	}
+	if err = scanner.Err(); err != nil {
+		err = errors.Wrapf(err, "failed to read audit log(=%s)", path)
+	}
+	return
+}
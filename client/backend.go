@@ -0,0 +1,304 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	eclient "github.com/tak1827/eth-extended-client/client"
+	"github.com/tak1827/go-cache/lru"
+	"github.com/tak1827/transaction-confirmer/confirm"
+)
+
+// ChainBackend is everything BlockchainClient needs from a node.
+// A nil block number means the latest block, PendingBlock the pending one.
+type ChainBackend interface {
+	Start()
+	Stop()
+
+	// SyncSend returns once the transaction is confirmed, AsyncSend as soon as it is broadcast.
+	// Transactions sent asynchronously are confirmed in background after EnqueueTxHash.
+	// BlockchainClient sends with AsyncSend only, and waits for the confirmation of its sync sends itself.
+	SyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (string, error)
+	AsyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (string, error)
+	EnqueueTxHash(ctx context.Context, hash string) error
+
+	Receipt(ctx context.Context, hash string) (*types.Receipt, error)
+	CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) ([]byte, error)
+	BalanceAt(ctx context.Context, account common.Address, block *big.Int) (*big.Int, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// pendingPollInterval is how often waitPending checks the pending transactions.
+const pendingPollInterval = 200 * time.Millisecond
+
+// PendingBlock is the block number of the pending block, as understood by ethclient.
+var PendingBlock = big.NewInt(-1)
+
+// BlockTagReader is implemented by the backends able to resolve block tags such as "safe" or "finalized".
+type BlockTagReader interface {
+	HeaderByTag(ctx context.Context, tag string) (*types.Header, error)
+}
+
+// toBlockNumArg encodes a block number the way ethclient does.
+func toBlockNumArg(block *big.Int) string {
+	if block == nil {
+		return "latest"
+	}
+	if block.Cmp(PendingBlock) == 0 {
+		return "pending"
+	}
+	return hexutil.EncodeBig(block)
+}
+
+// BatchCaller is implemented by the backends able to send several calls in a single round trip.
+type BatchCaller interface {
+	BatchCallContract(ctx context.Context, calls []Call, block *big.Int) ([]CallResult, error)
+}
+
+// batchCallContract falls back to one call after another when the backend can't batch.
+func batchCallContract(ctx context.Context, b ChainBackend, calls []Call, block *big.Int) ([]CallResult, error) {
+	if batcher, ok := b.(BatchCaller); ok {
+		return batcher.BatchCallContract(ctx, calls, block)
+	}
+
+	results := make([]CallResult, len(calls))
+	for i, call := range calls {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		results[i].Output, results[i].Err = b.CallContract(ctx, call.To, call.Input, block)
+	}
+	return results, nil
+}
+
+// EthBackend is the default ChainBackend, built on eth-extended-client.
+type EthBackend struct {
+	// mu is held by resetNonces until no call is using client
+	mu      sync.RWMutex
+	client  *eclient.Client
+	started bool
+
+	raw *ethclient.Client
+	rpc *rpc.Client
+
+	endpoint string
+}
+
+var (
+	_ ChainBackend   = (*EthBackend)(nil)
+	_ BatchCaller    = (*EthBackend)(nil)
+	_ BlockTagReader = (*EthBackend)(nil)
+)
+
+// NewEthBackend dials the endpoint once, the raw calls sharing the connection of the eth client.
+func NewEthBackend(ctx context.Context, endpoint string, cfmOpts []confirm.Opt, opts ...eclient.Option) (b *EthBackend, err error) {
+	b = &EthBackend{endpoint: endpoint}
+
+	client, err := eclient.NewClient(ctx, endpoint, cfmOpts, opts...)
+	if err != nil {
+		err = errors.Wrap(err, "failed to create eth client")
+		return
+	}
+	b.client = &client
+
+	// eth-extended-client can't be given a connection, nor does it expose the one it dials
+	b.raw = *(**ethclient.Client)(fieldPointer(b.client, "ethclient", reflect.TypeOf(b.raw)))
+	b.rpc = *(**rpc.Client)(fieldPointer(b.raw, "c", reflect.TypeOf(b.rpc)))
+	return
+}
+
+// fieldPointer points to the unexported field of the struct v points to, panicking if the field is not of the given type.
+func fieldPointer(v interface{}, name string, typ reflect.Type) unsafe.Pointer {
+	f := reflect.ValueOf(v).Elem().FieldByName(name)
+	if !f.IsValid() || f.Type() != typ {
+		panic(fmt.Sprintf("%T has no field %s of type %s", v, name, typ))
+	}
+	return unsafe.Pointer(f.UnsafeAddr())
+}
+
+func (b *EthBackend) Start() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.started = true
+	b.client.Start()
+}
+
+// Stop may be called without Start, which eth-extended-client can't do unless started.
+// It closes the connection shared with the raw calls.
+func (b *EthBackend) Stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.started {
+		b.client.Start()
+	}
+	b.client.Stop()
+}
+
+// resetNonces makes the eth client forget the nonces it assigned, once the calls using it are done,
+// so that they are read from the chain again.
+func (b *EthBackend) resetNonces() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cash := *(**eclient.NonceCash)(fieldPointer(b.client, "nonceCash", reflect.TypeOf(&eclient.NonceCash{})))
+	cash.Lock()
+	defer cash.Unlock()
+
+	(*lru.LRUCache)(fieldPointer(cash, "nonces", reflect.TypeOf(lru.LRUCache{}))).Clear()
+}
+
+// waitPending waits until the transactions of the account known by the node are mined,
+// so that the nonce read from the chain is not one of them.
+func (b *EthBackend) waitPending(ctx context.Context, account common.Address) error {
+	for {
+		pending, err := b.raw.PendingNonceAt(ctx, account)
+		if err != nil {
+			return errors.Wrap(err, "failed to get pending nonce")
+		}
+		mined, err := b.raw.NonceAt(ctx, account, nil)
+		if err != nil {
+			return errors.Wrap(err, "failed to get nonce")
+		}
+		if pending <= mined {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "%d transactions of %s still pending", pending-mined, account.String())
+		case <-time.After(pendingPollInterval):
+		}
+	}
+}
+
+func (b *EthBackend) SyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (string, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.client.SyncSend(ctx, priv, to, amount, input, gasLimit)
+}
+
+func (b *EthBackend) AsyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (string, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.client.AsyncSend(ctx, priv, to, amount, input, gasLimit)
+}
+
+func (b *EthBackend) EnqueueTxHash(ctx context.Context, hash string) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.client.EnqueueTxHash(ctx, hash)
+}
+
+func (b *EthBackend) Receipt(ctx context.Context, hash string) (*types.Receipt, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.client.Receipt(ctx, hash)
+}
+
+func (b *EthBackend) CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) (output []byte, err error) {
+	if block == nil {
+		b.mu.RLock()
+		defer b.mu.RUnlock()
+
+		return b.client.QueryContract(ctx, to, input)
+	}
+
+	if output, err = b.raw.CallContract(ctx, ethereum.CallMsg{To: &to, Data: input}, block); err != nil {
+		err = errors.Wrapf(err, "failed to call contract(=%s) at block(=%s)", to.String(), block)
+		return
+	}
+
+	if len(output) == 0 {
+		// same as QueryContract, make sure we have a contract to operate on
+		code, err := b.raw.CodeAt(ctx, to, block)
+		if err != nil {
+			return nil, errors.Wrap(err, "at ethclient.CodeAt")
+		} else if len(code) == 0 {
+			return nil, errors.Wrap(bind.ErrNoCode, "at ethclient.CodeAt")
+		}
+	}
+	return
+}
+
+// BatchCallContract sends the calls as a single JSON-RPC batch request.
+func (b *EthBackend) BatchCallContract(ctx context.Context, calls []Call, block *big.Int) ([]CallResult, error) {
+	blockArg := toBlockNumArg(block)
+
+	var (
+		elems   = make([]rpc.BatchElem, len(calls))
+		outputs = make([]hexutil.Bytes, len(calls))
+	)
+	for i, call := range calls {
+		arg := map[string]interface{}{
+			"to":   call.To,
+			"data": hexutil.Bytes(call.Input),
+		}
+		elems[i] = rpc.BatchElem{Method: "eth_call", Args: []interface{}{arg, blockArg}, Result: &outputs[i]}
+	}
+
+	if err := b.rpc.BatchCallContext(ctx, elems); err != nil {
+		return nil, errors.Wrapf(err, "failed to send batch of %d calls", len(calls))
+	}
+
+	results := make([]CallResult, len(calls))
+	for i, elem := range elems {
+		if elem.Error != nil {
+			results[i].Err = errors.Wrapf(elem.Error, "failed to call contract(=%s)", calls[i].To.String())
+			continue
+		}
+		results[i].Output = outputs[i]
+	}
+	return results, nil
+}
+
+func (b *EthBackend) BalanceAt(ctx context.Context, account common.Address, block *big.Int) (*big.Int, error) {
+	if block == nil {
+		b.mu.RLock()
+		defer b.mu.RUnlock()
+
+		return b.client.BalanceOf(ctx, account)
+	}
+	return b.raw.BalanceAt(ctx, account, block)
+}
+
+func (b *EthBackend) ChainID(ctx context.Context) (*big.Int, error) {
+	return b.raw.ChainID(ctx)
+}
+
+func (b *EthBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return b.raw.FilterLogs(ctx, query)
+}
+
+func (b *EthBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return b.raw.HeaderByNumber(ctx, number)
+}
+
+func (b *EthBackend) HeaderByTag(ctx context.Context, tag string) (head *types.Header, err error) {
+	if err = b.rpc.CallContext(ctx, &head, "eth_getBlockByNumber", tag, false); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the %s block", tag)
+	}
+	if head == nil {
+		return nil, errors.Wrapf(ethereum.NotFound, "%s block", tag)
+	}
+	return
+}
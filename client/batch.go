@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultBatchSize        = 100
+	DefaultBatchConcurrency = 4
+	DefaultLogPageSize      = uint64(5000)
+
+	// Multicall3Address is where Multicall3 is deployed on most chains
+	Multicall3Address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+	multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+)
+
+var (
+	ErrCallFailed = errors.New("call failed")
+
+	mcABI = mustParseABI(multicall3ABI)
+)
+
+// mustParseABI parses an ABI defined in the source, panicking at init like regexp.MustCompile when it is wrong.
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic("invalid ABI: " + err.Error())
+	}
+	return parsed
+}
+
+// Call is a read only call of a contract.
+type Call struct {
+	To    common.Address
+	Input []byte
+}
+
+// CallResult is the output of a Call, or why it failed.
+type CallResult struct {
+	Output []byte
+	Err    error
+}
+
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// multicall is the Multicall3 contract given WithMulticall, disabled once found missing.
+type multicall struct {
+	address  common.Address
+	disabled int32
+}
+
+// BatchCall runs the calls in chunks of the batch size, several chunks at once.
+// A chunk is a single JSON-RPC batch request, or a single call of Multicall3 when enabled WithMulticall.
+// The error is only about ctx, each result tells whether its call succeeded.
+func (c *BlockchainClient) BatchCall(ctx context.Context, calls []Call, block *big.Int) ([]CallResult, error) {
+	ctx, cancel := c.withTimeout(ctx, c.timeouts.rpc)
+	defer cancel()
+
+	var (
+		results = make([]CallResult, len(calls))
+		sem     = make(chan struct{}, c.batchConcurrency)
+		wg      sync.WaitGroup
+	)
+	for start := 0; start < len(calls); start += c.batchSize {
+		end := start + c.batchSize
+		if end > len(calls) {
+			end = len(calls)
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer func() {
+				if r := recover(); r != nil {
+					err := c.recovered("BatchCall", r)
+					for i := start; i < end; i++ {
+						results[i].Err = err
+					}
+				}
+				<-sem
+				wg.Done()
+			}()
+
+			chunk, err := c.callChunk(ctx, calls[start:end], block)
+			if err != nil {
+				err = errors.Wrapf(err, "failed to call chunk[%d:%d]", start, end)
+				for i := start; i < end; i++ {
+					results[i].Err = err
+				}
+				return
+			}
+			copy(results[start:end], chunk)
+		}(start, end)
+	}
+	wg.Wait()
+
+	return results, ctx.Err()
+}
+
+func (c *BlockchainClient) callChunk(ctx context.Context, calls []Call, block *big.Int) ([]CallResult, error) {
+	if c.multicall == nil || atomic.LoadInt32(&c.multicall.disabled) == 1 {
+		return batchCallContract(ctx, c.backend, calls, block)
+	}
+
+	results, err := c.multicallChunk(ctx, calls, block)
+	if errors.Is(err, bind.ErrNoCode) {
+		c.logger.Warn().Msgf("multicall(=%s) is not deployed, fall back to JSON-RPC batch", c.multicall.address.String())
+		atomic.StoreInt32(&c.multicall.disabled, 1)
+		return batchCallContract(ctx, c.backend, calls, block)
+	}
+	return results, err
+}
+
+func (c *BlockchainClient) multicallChunk(ctx context.Context, calls []Call, block *big.Int) (results []CallResult, err error) {
+	args := make([]multicall3Call, len(calls))
+	for i, call := range calls {
+		args[i] = multicall3Call{Target: call.To, AllowFailure: true, CallData: call.Input}
+	}
+
+	input, err := mcABI.Pack("aggregate3", args)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack aggregate3")
+	}
+	output, err := c.backend.CallContract(ctx, c.multicall.address, input, block)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call multicall(=%s)", c.multicall.address.String())
+	}
+
+	var returned []multicall3Result
+	if err = unpack(&mcABI, "aggregate3", output, &returned); err != nil {
+		return nil, errors.Wrapf(err, "multicall(=%s)", c.multicall.address.String())
+	}
+	if len(returned) != len(calls) {
+		return nil, errors.Errorf("multicall returned %d results for %d calls", len(returned), len(calls))
+	}
+
+	results = make([]CallResult, len(calls))
+	for i, r := range returned {
+		if !r.Success {
+			results[i].Err = errors.Wrapf(ErrCallFailed, "contract=%s", calls[i].To.String())
+			continue
+		}
+		results[i].Output = r.ReturnData
+	}
+	return
+}
+
+// BatchBalanceOf reads the balances of many accounts with BatchCall. Failing accounts carry their error.
+func (c *BlockchainClient) BatchBalanceOf(ctx context.Context, req data.BatchBalanceOfRequest) (resp data.BatchBalanceOfResponse, err error) {
+	ctx, call := c.begin(ctx, "BatchBalanceOf", data.RequestType_BATCH_BALANCE_OF, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	block, err := c.blockAt(ctx, req.GetBlock())
+	if err != nil {
+		return
+	}
+
+	contractAddress := common.HexToAddress(req.GetContractAddress())
+	decimals, err := c.tokenDecimals(ctx, contractAddress)
+	if err != nil {
+		return
+	}
+
+	calls := make([]Call, len(req.GetAccounts()))
+	for i, account := range req.GetAccounts() {
+		input, err := pack(&c.stABI.ABI, "balanceOf", common.HexToAddress(account))
+		if err != nil {
+			return resp, err
+		}
+		calls[i] = Call{To: contractAddress, Input: input}
+	}
+
+	results, err := c.BatchCall(ctx, calls, block)
+	if err != nil {
+		return
+	}
+
+	resp.Decimals = uint32(decimals)
+	resp.Balances = make([]*data.AccountBalance, len(results))
+	for i, r := range results {
+		balance := &data.AccountBalance{Account: req.GetAccounts()[i]}
+		resp.Balances[i] = balance
+
+		if r.Err != nil {
+			balance.Error = r.Err.Error()
+			continue
+		}
+
+		var amount *big.Int
+		if uerr := unpack(&c.stABI.ABI, "balanceOf", r.Output, &amount); uerr != nil {
+			balance.Error = uerr.Error()
+			continue
+		}
+		balance.Amount = amount.String()
+		balance.FormattedAmount = data.FromWei(amount, int(decimals))
+	}
+	return
+}
@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ango-ya/chain-client/contract"
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	eclient "github.com/tak1827/eth-extended-client/client"
+)
+
+func TestBatchBalanceOf(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		accounts = []string{TestAccount, TestAccount2, TestAccount3, TestAccount4}
+	)
+	for i := 0; i < 5; i++ {
+		addr, _ := eclient.GenerateAddr()
+		accounts = append(accounts, addr.String())
+	}
+
+	// 2件ずつ、同時に2チャンクまで
+	c, err := NewBlockchainClient(TestEndpoint, WithTimeout(3), WithBatchSize(2), WithBatchConcurrency(2))
+	require.NoError(t, err)
+	c.Start()
+	defer c.Close()
+
+	res, err := c.BatchBalanceOf(ctx, data.BatchBalanceOfRequest{
+		ContractAddress: TestSecurityTokenAddress,
+		Accounts:        accounts,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(18), res.GetDecimals())
+	require.Len(t, res.GetBalances(), len(accounts))
+
+	for i, b := range res.GetBalances() {
+		require.Equal(t, accounts[i], b.GetAccount())
+		require.Empty(t, b.GetError())
+
+		balRes, err := c.BalanceOfSecurityToken(ctx, data.BalanceOfRequest{ContractAddress: TestSecurityTokenAddress, Account: accounts[i]})
+		require.NoError(t, err)
+		require.Equal(t, balRes.GetAmount(), b.GetAmount())
+		require.Equal(t, balRes.GetFormattedAmount(), b.GetFormattedAmount())
+	}
+
+	// 不正なアカウントはリクエストごと拒否される
+	_, err = c.BatchBalanceOf(ctx, data.BatchBalanceOfRequest{
+		ContractAddress: TestSecurityTokenAddress,
+		Accounts:        []string{TestAccount, "0x1234"},
+	})
+	require.ErrorIs(t, err, data.ErrInvalidAddress)
+}
+
+func TestBatchCall(t *testing.T) {
+	stABI, err := abi.JSON(strings.NewReader(contract.SecurityTokenABI))
+	require.NoError(t, err)
+
+	var (
+		ctx      = context.Background()
+		token    = common.HexToAddress(TestSecurityTokenAddress)
+		input, _ = stABI.Pack("name")
+		calls    = []Call{
+			{To: token, Input: input},
+			{To: token, Input: []byte{0xde, 0xad, 0xbe, 0xef}},
+			{To: token, Input: input},
+		}
+	)
+
+	for _, opts := range [][]Option{
+		{WithTimeout(3)},
+		// Multicall3がデプロイされていなければJSON-RPCのバッチに戻る
+		{WithTimeout(3), WithMulticall(Multicall3Address)},
+	} {
+		c, err := NewBlockchainClient(TestEndpoint, opts...)
+		require.NoError(t, err)
+		c.Start()
+
+		results, err := c.BatchCall(ctx, calls, nil)
+		require.NoError(t, err)
+		require.Len(t, results, len(calls))
+
+		// 失敗した呼び出しだけがエラーになる
+		for _, i := range []int{0, 2} {
+			require.NoError(t, results[i].Err)
+			unpacked, err := stABI.Unpack("name", results[i].Output)
+			require.NoError(t, err)
+			require.NotEmpty(t, unpacked[0])
+		}
+		require.Error(t, results[1].Err)
+
+		c.Close()
+	}
+}
+
+// multicallBackend answers aggregate3 calls, failing the calls to failing
+type multicallBackend struct {
+	ChainBackend
+
+	mu      sync.Mutex
+	failing common.Address
+	calls   int
+}
+
+func (b *multicallBackend) Start() {}
+func (b *multicallBackend) Stop()  {}
+func (b *multicallBackend) CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) ([]byte, error) {
+	b.mu.Lock()
+	b.calls++
+	b.mu.Unlock()
+
+	method, err := mcABI.MethodById(input)
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		calls   = *abi.ConvertType(args[0], new([]multicall3Call)).(*[]multicall3Call)
+		results = make([]multicall3Result, len(calls))
+	)
+	for i, call := range calls {
+		if call.Target == b.failing {
+			continue
+		}
+		results[i] = multicall3Result{Success: true, ReturnData: call.CallData}
+	}
+	return method.Outputs.Pack(results)
+}
+
+func TestMulticall(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		failing = common.HexToAddress(TestAccount2)
+		backend = &multicallBackend{failing: failing}
+		calls   = make([]Call, 5)
+	)
+	for i := range calls {
+		calls[i] = Call{To: common.HexToAddress(TestAccount), Input: []byte{byte(i)}}
+	}
+	calls[3].To = failing
+
+	c, err := NewBlockchainClient("http://localhost:0", WithBackend(backend), WithMulticall(Multicall3Address), WithBatchSize(2))
+	require.NoError(t, err)
+
+	results, err := c.BatchCall(ctx, calls, nil)
+	require.NoError(t, err)
+	for i, r := range results {
+		if i == 3 {
+			require.ErrorIs(t, r.Err, ErrCallFailed)
+			continue
+		}
+		require.NoError(t, r.Err)
+		require.Equal(t, []byte{byte(i)}, r.Output)
+	}
+
+	// チャンクごとに1回だけ呼び出される
+	require.Equal(t, 3, backend.calls)
+}
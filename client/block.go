@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrBlockTagUnsupported = errors.New("block tag unsupported by the backend")
+	ErrBlockNotFound       = errors.New("block not found")
+)
+
+func headerByTag(ctx context.Context, b ChainBackend, tag string) (*types.Header, error) {
+	r, ok := b.(BlockTagReader)
+	if !ok {
+		return nil, errors.Wrapf(ErrBlockTagUnsupported, "tag=%s", tag)
+	}
+	return r.HeaderByTag(ctx, tag)
+}
+
+// blockAt resolves the block field of a read request into the block number given to the backend.
+// Safe and finalized blocks are pinned to their number, so that every call of the request reads the same block.
+func (c *BlockchainClient) blockAt(ctx context.Context, block string) (*big.Int, error) {
+	number, tag, err := data.ParseBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case "":
+		return number, nil
+	case data.BlockLatest:
+		return nil, nil
+	case data.BlockPending:
+		return PendingBlock, nil
+	case data.BlockEarliest:
+		return big.NewInt(0), nil
+	}
+
+	header, err := headerByTag(ctx, c.backend, tag)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve the %s block", tag)
+	}
+	return header.Number, nil
+}
+
+// blockRef is the block field of a read request reading block, the reverse of blockAt.
+func blockRef(block *big.Int) string {
+	switch {
+	case block == nil:
+		return ""
+	case block.Cmp(PendingBlock) == 0:
+		return data.BlockPending
+	}
+	return block.String()
+}
+
+// BlockNumberAt finds the last block mined at or before t by binary search over the block headers.
+// It fails with ErrBlockNotFound when t is before the genesis block.
+func (c *BlockchainClient) BlockNumberAt(ctx context.Context, t time.Time) (uint64, error) {
+	if t.Unix() < 0 {
+		return 0, errors.Wrapf(ErrBlockNotFound, "no block at or before %s", t.UTC().Format(time.RFC3339))
+	}
+	timestamp := uint64(t.Unix())
+
+	ctx, cancel := c.withTimeout(ctx, c.timeouts.rpc)
+	defer cancel()
+
+	latest, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get the latest header")
+	}
+	if latest.Time <= timestamp {
+		return latest.Number.Uint64(), nil
+	}
+
+	// invariant: block lo is mined at or before t, block hi after t
+	var (
+		lo uint64
+		hi = latest.Number.Uint64()
+	)
+	genesis, err := c.headerAt(ctx, lo)
+	if err != nil {
+		return 0, err
+	}
+	if genesis.Time > timestamp {
+		return 0, errors.Wrapf(ErrBlockNotFound, "no block at or before %s", t.UTC().Format(time.RFC3339))
+	}
+
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		header, err := c.headerAt(ctx, mid)
+		if err != nil {
+			return 0, err
+		}
+		if header.Time <= timestamp {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
+
+func (c *BlockchainClient) headerAt(ctx context.Context, number uint64) (*types.Header, error) {
+	header, err := c.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the header of block(=%d)", number)
+	}
+	if header == nil {
+		return nil, errors.Wrapf(ErrBlockNotFound, "block(=%d)", number)
+	}
+	return header, nil
+}
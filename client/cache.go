@@ -0,0 +1,409 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ango-ya/chain-client/contract"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	DefaultCacheInvalidateInterval = int64(2000) // 2 sec
+	DefaultCacheMaxEntries         = 10000
+)
+
+// DefaultCacheTTLs are the methods cached by WithReadCache with their TTL in ms.
+// A TTL of 0 keeps the value until an event of the contract makes it stale.
+var DefaultCacheTTLs = map[string]int64{
+	// security token
+	"name":              600000,
+	"symbol":            0,
+	"decimals":          0,
+	"nowCompliance":     600000,
+	"complianceVersion": 600000,
+
+	// compliance service
+	"hasRole":            60000,
+	"DEFAULT_ADMIN_ROLE": 0,
+	"ST_CONTROL_ROLE":    0,
+	"ST_EDIT_ROLE":       0,
+	"ST_UPGRADE_ROLE":    0,
+}
+
+// cacheInvalidations are the cached methods made stale by an event of the contract.
+var cacheInvalidations = map[string][]string{
+	"NameUpdated":              {"name"},
+	"ComplianceServiceUpdated": {"nowCompliance", "complianceVersion"},
+	"RoleGranted":              {"hasRole"},
+	"RoleRevoked":              {"hasRole"},
+}
+
+type CacheOption interface {
+	Apply(*readCache)
+}
+
+type CacheTTLOpt struct {
+	method string
+	ttl    int64
+}
+
+func (o CacheTTLOpt) Apply(rc *readCache) {
+	rc.ttls[o.method] = o.ttl
+}
+
+// WithCacheTTL caches the view method of the security token or compliance service for ms, 0 meaning until invalidated by an event.
+func WithCacheTTL(method string, ms int64) CacheTTLOpt {
+	if ms < 0 {
+		panic("CacheTTL should not be negative")
+	}
+	return CacheTTLOpt{method: method, ttl: ms}
+}
+
+type CacheInvalidateIntervalOpt int64
+
+func (o CacheInvalidateIntervalOpt) Apply(rc *readCache) {
+	rc.interval = time.Duration(o) * time.Millisecond
+}
+
+// WithCacheInvalidateInterval sets how often the events invalidating the cache are polled.
+func WithCacheInvalidateInterval(ms int64) CacheInvalidateIntervalOpt {
+	if ms <= 0 {
+		panic("CacheInvalidateInterval should be positive")
+	}
+	return CacheInvalidateIntervalOpt(ms)
+}
+
+type CacheMaxEntriesOpt int
+
+func (o CacheMaxEntriesOpt) Apply(rc *readCache) {
+	rc.maxEntries = int(o)
+}
+
+// WithCacheMaxEntries bounds the number of cached outputs, the least recently used being dropped first.
+func WithCacheMaxEntries(n int) CacheMaxEntriesOpt {
+	if n <= 0 {
+		panic("CacheMaxEntries should be positive")
+	}
+	return CacheMaxEntriesOpt(n)
+}
+
+type cacheKey struct {
+	contract common.Address
+	input    string
+}
+
+type cacheEntry struct {
+	key     cacheKey
+	method  string
+	output  []byte
+	expires time.Time // zero when kept until invalidated
+}
+
+// readCache keeps the outputs of the calls at the latest block, and drops them on expiry or on the events making them stale.
+// At most maxEntries outputs are kept, the least recently used being dropped first.
+type readCache struct {
+	sync.Mutex
+
+	ttls        map[string]int64
+	methods     map[[4]byte]string // selector to method name
+	topics      map[common.Hash][]string
+	entries     map[cacheKey]*list.Element
+	recent      *list.List // of *cacheEntry, the most recently used first
+	maxEntries  int
+	generations map[common.Address]uint64
+	interval    time.Duration
+	timeout     time.Duration
+	lastBlock   uint64
+
+	// concurrent misses of the same call share a single call to the node
+	flight singleflight.Group
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newReadCache(opts ...CacheOption) *readCache {
+	rc := &readCache{
+		ttls:        make(map[string]int64, len(DefaultCacheTTLs)),
+		entries:     make(map[cacheKey]*list.Element),
+		recent:      list.New(),
+		maxEntries:  DefaultCacheMaxEntries,
+		generations: make(map[common.Address]uint64),
+		interval:    time.Duration(DefaultCacheInvalidateInterval) * time.Millisecond,
+	}
+	for method, ttl := range DefaultCacheTTLs {
+		rc.ttls[method] = ttl
+	}
+	for i := range opts {
+		opts[i].Apply(rc)
+	}
+	return rc
+}
+
+// resolve finds the selectors of the cached methods and the topics of the invalidating events in the ABIs.
+func (rc *readCache) resolve(abis ...*contract.ParsedABI) error {
+	rc.methods = make(map[[4]byte]string, len(rc.ttls))
+	rc.topics = make(map[common.Hash][]string, len(cacheInvalidations))
+
+	for name := range rc.ttls {
+		found := false
+		for _, a := range abis {
+			if m, ok := a.Methods[name]; ok && m.IsConstant() {
+				rc.methods[a.Selectors[name]] = name
+				found = true
+			}
+		}
+		if !found {
+			return errors.Errorf("unknown view method(=%s) to cache", name)
+		}
+	}
+
+	for name, methods := range cacheInvalidations {
+		for _, a := range abis {
+			if topic, ok := a.Topics[name]; ok {
+				rc.topics[topic] = methods
+			}
+		}
+	}
+	return nil
+}
+
+// method is the name of the cached method called by input.
+func (rc *readCache) method(input []byte) (string, bool) {
+	if len(input) < 4 {
+		return "", false
+	}
+	var selector [4]byte
+	copy(selector[:], input[:4])
+	name, ok := rc.methods[selector]
+	return name, ok
+}
+
+func (rc *readCache) get(contract common.Address, input []byte) ([]byte, bool) {
+	rc.Lock()
+	defer rc.Unlock()
+
+	elem, ok := rc.entries[cacheKey{contract: contract, input: string(input)}]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		rc.remove(elem)
+		return nil, false
+	}
+	rc.recent.MoveToFront(elem)
+	return entry.output, true
+}
+
+// remove must be called holding the lock.
+func (rc *readCache) remove(elem *list.Element) {
+	delete(rc.entries, elem.Value.(*cacheEntry).key)
+	rc.recent.Remove(elem)
+}
+
+func (rc *readCache) generation(contract common.Address) uint64 {
+	rc.Lock()
+	defer rc.Unlock()
+
+	return rc.generations[contract]
+}
+
+// set keeps the output unless the contract was invalidated since generation, as the output may predate the event.
+func (rc *readCache) set(contract common.Address, method string, input, output []byte, generation uint64) {
+	rc.Lock()
+	defer rc.Unlock()
+
+	if rc.generations[contract] != generation {
+		return
+	}
+
+	key := cacheKey{contract: contract, input: string(input)}
+	if elem, ok := rc.entries[key]; ok {
+		rc.remove(elem)
+	}
+
+	entry := &cacheEntry{key: key, method: method, output: output}
+	if ttl := rc.ttls[method]; ttl > 0 {
+		entry.expires = time.Now().Add(time.Duration(ttl) * time.Millisecond)
+	}
+	rc.entries[key] = rc.recent.PushFront(entry)
+
+	for rc.recent.Len() > rc.maxEntries {
+		rc.remove(rc.recent.Back())
+	}
+}
+
+func (rc *readCache) invalidate(contract common.Address, methods ...string) {
+	rc.Lock()
+	defer rc.Unlock()
+
+	rc.generations[contract]++
+	for key, elem := range rc.entries {
+		if key.contract != contract {
+			continue
+		}
+		for _, method := range methods {
+			if elem.Value.(*cacheEntry).method == method {
+				rc.remove(elem)
+				break
+			}
+		}
+	}
+}
+
+func (rc *readCache) start(backend ChainBackend, timeout time.Duration) {
+	rc.timeout = timeout
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rc.cancel = cancel
+	rc.done = make(chan struct{})
+
+	go func() {
+		defer close(rc.done)
+
+		ticker := time.NewTicker(rc.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				rc.poll(ctx, backend)
+			}
+		}
+	}()
+}
+
+func (rc *readCache) stop() {
+	if rc.cancel == nil {
+		return
+	}
+
+	rc.cancel()
+	<-rc.done
+}
+
+// poll invalidates the entries made stale by the events mined since the last poll.
+// Failures are retried on the next tick, from the same block.
+func (rc *readCache) poll(ctx context.Context, backend ChainBackend) {
+	ctx, cancel := context.WithTimeout(ctx, rc.timeout)
+	defer cancel()
+
+	header, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return
+	}
+	latest := header.Number.Uint64()
+
+	rc.Lock()
+	var (
+		from      = rc.lastBlock + 1
+		first     = rc.lastBlock == 0
+		contracts = make([]common.Address, 0)
+		seen      = make(map[common.Address]bool)
+	)
+	for key := range rc.entries {
+		if !seen[key.contract] {
+			seen[key.contract] = true
+			contracts = append(contracts, key.contract)
+		}
+	}
+	rc.Unlock()
+
+	// the events before the first poll are unknown
+	if first {
+		rc.Lock()
+		rc.entries = make(map[cacheKey]*list.Element)
+		rc.recent.Init()
+		for _, contract := range contracts {
+			rc.generations[contract]++
+		}
+		rc.lastBlock = latest
+		rc.Unlock()
+		return
+	}
+
+	if latest < from {
+		return
+	}
+
+	if len(contracts) > 0 {
+		topics := make([]common.Hash, 0, len(rc.topics))
+		for topic := range rc.topics {
+			topics = append(topics, topic)
+		}
+
+		logs, err := backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   header.Number,
+			Addresses: contracts,
+			Topics:    [][]common.Hash{topics},
+		})
+		if err != nil {
+			return
+		}
+
+		for _, log := range logs {
+			if len(log.Topics) > 0 {
+				rc.invalidate(log.Address, rc.topics[log.Topics[0]]...)
+			}
+		}
+	}
+
+	rc.Lock()
+	rc.lastBlock = latest
+	rc.Unlock()
+}
+
+// callContract reads through the cache when enabled WithReadCache. Only the calls at the latest block are cached.
+func (c *BlockchainClient) callContract(ctx context.Context, to common.Address, input []byte, block *big.Int) ([]byte, error) {
+	if c.cache == nil || block != nil {
+		return c.backend.CallContract(ctx, to, input, block)
+	}
+
+	method, ok := c.cache.method(input)
+	if !ok {
+		return c.backend.CallContract(ctx, to, input, nil)
+	}
+
+	if output, ok := c.cache.get(to, input); ok {
+		c.metrics.observeCache(method, true)
+		return output, nil
+	}
+	c.metrics.observeCache(method, false)
+
+	// the call is shared by the concurrent misses, so it must not fail with the context of any of them
+	flight := c.cache.flight.DoChan(to.Hex()+string(input), func() (interface{}, error) {
+		fctx, cancel := context.WithTimeout(trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx)), c.timeouts.rpc)
+		defer cancel()
+
+		generation := c.cache.generation(to)
+		output, err := c.backend.CallContract(fctx, to, input, nil)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.set(to, method, input, output, generation)
+		return output, nil
+	})
+
+	var res singleflight.Result
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res = <-flight:
+	}
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return res.Val.([]byte), nil
+}
@@ -0,0 +1,218 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ango-ya/chain-client/contract"
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	eclient "github.com/tak1827/eth-extended-client/client"
+)
+
+// countingBackend serves a token and counts the calls by method
+type countingBackend struct {
+	ChainBackend
+
+	mu    sync.Mutex
+	stABI abi.ABI
+	calls map[string]int
+	delay time.Duration
+}
+
+func (b *countingBackend) Start() {}
+func (b *countingBackend) Stop()  {}
+func (b *countingBackend) CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) ([]byte, error) {
+	method, err := b.stABI.MethodById(input)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	b.calls[method.Name]++
+	b.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(b.delay):
+	}
+
+	switch method.Name {
+	case "name", "symbol":
+		return method.Outputs.Pack("Stub")
+	case "decimals":
+		return method.Outputs.Pack(uint8(18))
+	default:
+		return method.Outputs.Pack(big.NewInt(1))
+	}
+}
+
+func (b *countingBackend) count(method string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.calls[method]
+}
+
+func TestReadCache(t *testing.T) {
+	stABI, err := abi.JSON(strings.NewReader(contract.SecurityTokenABI))
+	require.NoError(t, err)
+
+	var (
+		ctx     = context.Background()
+		metrics = NewMetrics()
+		backend = &countingBackend{stABI: stABI, calls: make(map[string]int)}
+		nameReq = data.NameRequest{ContractAddress: TestSecurityTokenAddress}
+	)
+	c, err := NewBlockchainClient("http://localhost:0", WithBackend(backend), WithMetrics(metrics), WithReadCache(WithCacheTTL("symbol", 50)))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = c.NameSecurityToken(ctx, nameReq)
+		require.NoError(t, err)
+		_, err = c.SymbolSecurityToken(ctx, data.SymbolRequest(nameReq))
+		require.NoError(t, err)
+		_, err = c.TotalSupplySecurityToken(ctx, data.TotalSupplyRequest(nameReq))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, backend.count("name"))
+	require.Equal(t, 1, backend.count("symbol"))
+	require.Equal(t, 1, backend.count("decimals"))
+	// キャッシュ対象外のメソッド
+	require.Equal(t, 3, backend.count("totalSupply"))
+
+	// 過去のブロックはキャッシュしない
+	nameReq.Block = "1"
+	_, err = c.NameSecurityToken(ctx, nameReq)
+	require.NoError(t, err)
+	require.Equal(t, 2, backend.count("name"))
+
+	// TTLが切れると再取得する
+	time.Sleep(60 * time.Millisecond)
+	_, err = c.SymbolSecurityToken(ctx, data.SymbolRequest{ContractAddress: TestSecurityTokenAddress})
+	require.NoError(t, err)
+	require.Equal(t, 2, backend.count("symbol"))
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+
+	require.Contains(t, body, `chain_client_cache_lookups_total{method="name",result="hit"} 2`)
+	require.Contains(t, body, `chain_client_cache_lookups_total{method="name",result="miss"} 1`)
+	require.Contains(t, body, `chain_client_cache_lookups_total{method="symbol",result="miss"} 2`)
+
+	// 存在しないメソッド
+	_, err = NewBlockchainClient("http://localhost:0", WithBackend(backend), WithReadCache(WithCacheTTL("unknown", 1)))
+	require.Error(t, err)
+}
+
+func TestReadCacheBound(t *testing.T) {
+	stABI, err := abi.JSON(strings.NewReader(contract.SecurityTokenABI))
+	require.NoError(t, err)
+
+	var (
+		ctx     = context.Background()
+		backend = &countingBackend{stABI: stABI, calls: make(map[string]int), delay: 50 * time.Millisecond}
+		nameReq = data.NameRequest{ContractAddress: TestSecurityTokenAddress}
+	)
+	c, err := NewBlockchainClient("http://localhost:0", WithBackend(backend), WithReadCache(WithCacheMaxEntries(1)))
+	require.NoError(t, err)
+
+	// 同時のミスは1回の呼び出しにまとめる
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.NameSecurityToken(ctx, nameReq)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, backend.count("name"))
+
+	// 上限を超えると古いものから破棄する
+	_, err = c.SymbolSecurityToken(ctx, data.SymbolRequest(nameReq))
+	require.NoError(t, err)
+	require.Len(t, c.cache.entries, 1)
+	_, err = c.NameSecurityToken(ctx, nameReq)
+	require.NoError(t, err)
+	require.Equal(t, 2, backend.count("name"))
+
+	require.Panics(t, func() { WithCacheMaxEntries(0) })
+
+	// 最初の呼び出し元がキャンセルしても、他の呼び出し元は結果を受け取る
+	nameReq.ContractAddress = TestComplianceAddress
+	cctx, cancel := context.WithCancel(ctx)
+	canceled := make(chan error)
+	go func() {
+		_, err := c.NameSecurityToken(cctx, nameReq)
+		canceled <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	res, err := c.NameSecurityToken(ctx, nameReq)
+	require.NoError(t, err)
+	require.Equal(t, "Stub", res.GetName())
+	require.ErrorIs(t, <-canceled, context.Canceled)
+	require.Equal(t, 3, backend.count("name"))
+}
+
+func TestReadCacheInvalidation(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		grantee, _ = eclient.GenerateAddr()
+		roleReq    = data.HasRoleRequest{ContractAddress: TestComplianceAddress, Role: data.ST_EDIT_ROLE, Account: grantee.String()}
+		grantReq   = data.GrantRoleRequest{PrivateKey: TestPrivKey, ContractAddress: TestComplianceAddress, Role: data.ST_EDIT_ROLE, Grantee: grantee.String()}
+	)
+	c, err := NewBlockchainClient(TestEndpoint, WithTimeout(3), WithReadCache(WithCacheInvalidateInterval(20)))
+	require.NoError(t, err)
+	c.Start()
+	defer c.Close()
+
+	other, err := NewBlockchainClient(TestEndpoint, WithTimeout(3))
+	require.NoError(t, err)
+	other.Start()
+	defer other.Close()
+
+	// 監視の開始を待つ
+	time.Sleep(100 * time.Millisecond)
+
+	res, err := c.HasRole(ctx, roleReq)
+	require.NoError(t, err)
+	require.False(t, res.GetHas())
+
+	// 別のクライアントでロールを付与すると、RoleGrantedイベントでキャッシュが破棄される
+	_, err = other.GrantRole(ctx, grantReq)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		res, err := c.HasRole(ctx, roleReq)
+		return err == nil && res.GetHas()
+	}, 2*time.Second, 20*time.Millisecond)
+
+	// 自身の書き込みではすぐに破棄される
+	grantee, _ = eclient.GenerateAddr()
+	roleReq.Account, grantReq.Grantee = grantee.String(), grantee.String()
+	res, err = c.HasRole(ctx, roleReq)
+	require.NoError(t, err)
+	require.False(t, res.GetHas())
+
+	_, err = c.GrantRole(ctx, grantReq)
+	require.NoError(t, err)
+	res, err = c.HasRole(ctx, roleReq)
+	require.NoError(t, err)
+	require.True(t, res.GetHas())
+}
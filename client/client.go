@@ -4,50 +4,124 @@ import (
 	"context"
 	"encoding/hex"
 	"math/big"
-	"strings"
+	"reflect"
 	"time"
 
 	"github.com/ango-ya/chain-client/contract"
 	"github.com/ango-ya/chain-client/data"
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	eclient "github.com/tak1827/eth-extended-client/client"
 	"github.com/tak1827/transaction-confirmer/confirm"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
-	timeoutDuration time.Duration
+	ErrTxReverted           = errors.New("transaction reverted")
+	ErrCreatedEventNotFound = errors.New("created event not found")
 )
 
+// backendTimeout is given to eth-extended-client, which keeps its timeouts in package variables shared by every client.
+// It is the same for all clients and long enough to never fire, the deadlines being set on the context by each client.
+const backendTimeout = int64(24 * 60 * 60) // 1 day
+
+// confirmerConfig tunes the confirmation of the sent transactions.
+type confirmerConfig struct {
+	workers              int
+	workerInterval       int64   // ms
+	confirmationInterval int64   // sec
+	confirmationBlock    *uint64 // nil to follow the network profile
+	syncSendInterval     int64   // ms
+}
+
+// validate makes sure a transaction can be confirmed within the confirm timeout.
+func (cfg confirmerConfig) validate(timeout time.Duration) error {
+	intervals := []struct {
+		name     string
+		interval time.Duration
+	}{
+		{"confirm worker interval", time.Duration(cfg.workerInterval) * time.Millisecond},
+		{"confirmation interval", time.Duration(cfg.confirmationInterval) * time.Second},
+		{"sync send confirm interval", time.Duration(cfg.syncSendInterval) * time.Millisecond},
+	}
+	for _, i := range intervals {
+		if i.interval >= timeout {
+			return errors.Errorf("%s(=%s) should be shorter than the confirm timeout(=%s)", i.name, i.interval, timeout)
+		}
+	}
+	return nil
+}
+
+// timeouts are kept per client, resolved by NewBlockchainClient.
+type timeouts struct {
+	dial    time.Duration
+	rpc     time.Duration
+	confirm time.Duration
+	methods map[string]time.Duration
+}
+
 type BlockchainClient struct {
-	ethclient eclient.Client
+	backend ChainBackend
 
-	stABI abi.ABI
-	csABI abi.ABI
-	fcABI abi.ABI
+	stABI *contract.ParsedABI
+	csABI *contract.ParsedABI
+	fcABI *contract.ParsedABI
 
-	timeout int64
-	logger  zerolog.Logger
+	tracker  *txTracker
+	decimals *decimalsCache
+	cache    *readCache
+	metrics  *Metrics
+	tracer   trace.Tracer
+
+	auditSink AuditSink
+
+	endpoints    []Endpoint
+	failoverOpts []FailoverOption
+	network      *NetworkProfile
+	validateOpts []data.ValidateOption
+
+	lazy          bool
+	reconnectOpts []ReconnectOption
+
+	batchSize        int
+	batchConcurrency int
+	multicall        *multicall
+	logPageSize      uint64
+
+	timeout   int64
+	timeouts  timeouts
+	confirmer confirmerConfig
+	logger    zerolog.Logger
 }
 
 func NewBlockchainClient(endpoint string, opts ...Option) (c BlockchainClient, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 8*time.Second)
-	defer cancel()
-
 	c.timeout = DefaultTimeout
+	c.timeouts.dial = time.Duration(DefaultDialTimeout) * time.Second
+	c.confirmer = confirmerConfig{
+		workers:              DefaultConfirmWorkers,
+		workerInterval:       DefaultConfirmWorkerInterval,
+		confirmationInterval: DefaultConfirmationInterval,
+		syncSendInterval:     DefaultSyncSendConfirmInterval,
+	}
 	c.logger = DefaultLogger
+	c.tracker = newTxTracker()
+	c.decimals = newDecimalsCache()
+	c.batchSize = DefaultBatchSize
+	c.batchConcurrency = DefaultBatchConcurrency
+	c.logPageSize = DefaultLogPageSize
 
-	if c.stABI, err = abi.JSON(strings.NewReader(contract.SecurityTokenABI)); err != nil {
+	if c.stABI, err = contract.ParsedSecurityTokenABI(); err != nil {
 		return
 	}
 
-	if c.csABI, err = abi.JSON(strings.NewReader(contract.ComplianceServiceABI)); err != nil {
+	if c.csABI, err = contract.ParsedComplianceServiceABI(); err != nil {
 		return
 	}
 
-	if c.fcABI, err = abi.JSON(strings.NewReader(contract.FactoryV0ABI)); err != nil {
+	if c.fcABI, err = contract.ParsedFactoryV0ABI(); err != nil {
 		return
 	}
 
@@ -55,51 +129,213 @@ func NewBlockchainClient(endpoint string, opts ...Option) (c BlockchainClient, e
 		opts[i].Apply(&c)
 	}
 
+	if c.cache != nil {
+		if err = c.cache.resolve(c.stABI, c.csABI); err != nil {
+			return
+		}
+	}
+
+	if c.timeouts.rpc == 0 {
+		c.timeouts.rpc = time.Duration(c.timeout) * time.Second
+	}
+	if c.timeouts.confirm == 0 {
+		c.timeouts.confirm = time.Duration(c.timeout) * time.Second
+	}
+	c.tracker.timeout = c.timeouts.confirm
+	c.tracker.rpcTimeout = c.timeouts.rpc
+
+	for method := range c.timeouts.methods {
+		if _, ok := reflect.TypeOf(&c).MethodByName(method); !ok {
+			err = errors.Errorf("unknown method(=%s) of the method timeout", method)
+			return
+		}
+	}
+	if _, ok := c.timeouts.methods["OnboardInvestor"]; !ok {
+		// onboarding sends up to two transactions
+		WithMethodTimeout("OnboardInvestor", int64(2*(c.timeouts.rpc+c.timeouts.confirm)/time.Second)).Apply(&c)
+	}
+
+	if err = c.confirmer.validate(c.timeouts.confirm); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeouts.dial)
+	defer cancel()
+
+	var confirmationBlock uint64
+	switch {
+	case c.confirmer.confirmationBlock != nil:
+		confirmationBlock = *c.confirmer.confirmationBlock
+	case c.network != nil:
+		confirmationBlock = c.network.ConfirmationBlock
+	}
+	c.confirmer.confirmationBlock = &confirmationBlock
+
 	cfmOpts := []confirm.Opt{
-		confirm.WithWorkers(1),
-		confirm.WithWorkerInterval(32),
-		confirm.WithConfirmationBlock(0),
-		confirm.WithTimeout(c.timeout),
+		confirm.WithWorkers(c.confirmer.workers),
+		confirm.WithWorkerInterval(c.confirmer.workerInterval),
+		confirm.WithConfirmationInterval(c.confirmer.confirmationInterval),
+		confirm.WithConfirmationBlock(confirmationBlock),
+		confirm.WithTimeout(int64(c.timeouts.rpc / time.Second)),
 	}
 
 	ethOpts := []eclient.Option{
 		eclient.WithLoggerOpt(c.logger),
-		eclient.WithTimeout(c.timeout),
-		eclient.WithSyncSendTimeout(c.timeout),
-		eclient.WithSyncSendConfirmInterval(128),
+		eclient.WithTimeout(backendTimeout),
+		eclient.WithSyncSendTimeout(backendTimeout),
 	}
 
-	if c.ethclient, err = eclient.NewClient(ctx, endpoint, cfmOpts, ethOpts...); err != nil {
-		err = errors.Wrap(err, "failed to create eth client")
-		return
+	if c.network != nil {
+		ethOpts = append(ethOpts, eclient.WithGasPrice(c.network.Fee.GasPrice))
+		c.failoverOpts = append([]FailoverOption{WithChainID(c.network.ChainID)}, c.failoverOpts...)
+	}
+
+	dial := func(ctx context.Context) (backend ChainBackend, err error) {
+		if len(c.endpoints) > 0 {
+			opts := append([]FailoverOption{WithFailoverLogger(c.logger)}, c.failoverOpts...)
+			if backend, err = NewFailoverBackend(ctx, c.endpoints, cfmOpts, ethOpts, opts...); err != nil {
+				return nil, err
+			}
+		} else if backend, err = NewEthBackend(ctx, endpoint, cfmOpts, ethOpts...); err != nil {
+			return nil, err
+		}
+
+		if c.network != nil {
+			if err = c.verifyChainID(ctx, backend); err != nil {
+				// eth-extended-client can't be stopped unless started
+				backend.Start()
+				backend.Stop()
+				return nil, err
+			}
+		}
+		return backend, nil
 	}
 
-	timeoutDuration = time.Duration(time.Duration(c.timeout) * time.Second)
+	switch {
+	case c.backend != nil:
+		if c.network != nil {
+			if err = c.verifyChainID(ctx, c.backend); err != nil {
+				return
+			}
+		}
+	case c.lazy:
+		opts := append([]ReconnectOption{WithReconnectLogger(c.logger)}, c.reconnectOpts...)
+		c.backend = NewLazyBackend(dial, c.timeouts.dial, opts...)
+	default:
+		if c.backend, err = dial(ctx); err != nil {
+			return
+		}
+	}
+
+	if c.tracer != nil {
+		c.backend = &tracedBackend{ChainBackend: c.backend, tracer: c.tracer}
+	}
 
 	return
 }
 
 func (c *BlockchainClient) Start() {
-	c.ethclient.Start()
+	c.backend.Start()
+	c.tracker.start(untraced(c.backend).Receipt)
+	if c.cache != nil {
+		c.cache.start(untraced(c.backend), c.timeouts.rpc)
+	}
 }
 
 func (c *BlockchainClient) Close() {
-	c.ethclient.Stop()
+	c.tracker.stop()
+	if c.cache != nil {
+		c.cache.stop()
+	}
+	c.backend.Stop()
+}
+
+// Network returns the profile given WithNetworkProfile.
+func (c *BlockchainClient) Network() (NetworkProfile, bool) {
+	if c.network == nil {
+		return NetworkProfile{}, false
+	}
+	return *c.network, true
+}
+
+// Endpoints returns the state of the endpoints when the client was built WithEndpoints, nil otherwise.
+func (c *BlockchainClient) Endpoints() []EndpointState {
+	backend := untraced(c.backend)
+	if b, ok := backend.(*LazyBackend); ok {
+		backend = nil
+		if conn := b.current(); conn != nil {
+			backend = conn.ChainBackend
+		}
+	}
+
+	if b, ok := backend.(*FailoverBackend); ok {
+		return b.Endpoints()
+	}
+	return nil
+}
+
+// Health reports the connection of the client to its node. Only a client built WithLazyConnect
+// may be constructed without being connected, otherwise the client is ready as long as one endpoint is healthy.
+func (c *BlockchainClient) Health() Health {
+	switch b := untraced(c.backend).(type) {
+	case *LazyBackend:
+		return b.Health()
+	case *FailoverBackend:
+		h := Health{Ready: b.current() != nil}
+		for _, s := range b.Endpoints() {
+			if s.CheckedAt.After(h.CheckedAt) {
+				h.CheckedAt = s.CheckedAt
+			}
+			if !s.Healthy && h.Err == "" {
+				h.Err = s.Err
+			}
+		}
+		return h
+	default:
+		return Health{Ready: true}
+	}
+}
+
+// Ready tells if the client is connected to its node.
+func (c *BlockchainClient) Ready() bool {
+	return c.Health().Ready
+}
+
+func (c *BlockchainClient) LatestBlockNumber(ctx context.Context) (uint64, error) {
+	ctx, cancel := c.withTimeout(ctx, c.timeouts.rpc)
+	defer cancel()
+
+	header, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return header.Number.Uint64(), nil
+}
+
+func (c *BlockchainClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	ctx, cancel := c.withTimeout(ctx, c.timeouts.rpc)
+	defer cancel()
+
+	return c.backend.FilterLogs(ctx, query)
 }
 
 func (c *BlockchainClient) SendETH(ctx context.Context, req data.SendETHRequest) (resp data.SendETHResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	ctx, call := c.begin(ctx, "SendETH", data.RequestType_SEND_ETH, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
 		return
 	}
 
 	var (
 		recipient = common.HexToAddress(req.GetRecipient())
-		amount, _ = data.ToWei(req.GetAmount(), 18)
+		amount, _ = data.ToWei(req.GetAmount(), EtherDecimals)
 	)
-	hash, err := c.ethclient.SyncSend(ctx, req.GetPrivateKey(), &recipient, amount, nil, 0)
+	hash, _, err := c.syncSend(ctx, call, req.GetPrivateKey(), &recipient, amount, nil, 0)
 	if err != nil {
 		err = errors.Wrap(err, "failed sync send transaction")
+		resp.Hash = hash
 		return
 	}
 
@@ -112,47 +348,59 @@ func (c *BlockchainClient) SendETH(ctx context.Context, req data.SendETHRequest)
 }
 
 func (c *BlockchainClient) BalanceOfETH(ctx context.Context, req data.BalanceOfETHRequest) (resp data.BalanceOfETHResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	ctx, call := c.begin(ctx, "BalanceOfETH", data.RequestType_BALANCE_OF_ETH, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	block, err := c.blockAt(ctx, req.GetBlock())
+	if err != nil {
 		return
 	}
 
 	var (
 		account = common.HexToAddress(req.GetAccount())
 	)
-	amount, err := c.ethclient.BalanceOf(ctx, account)
+	amount, err := c.backend.BalanceAt(ctx, account, block)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to get the balance of %s", req.GetAccount())
 		return
 	}
 
 	resp = data.BalanceOfETHResponse{
-		Amount: amount.String(),
+		Amount:          amount.String(),
+		FormattedAmount: data.FromWei(amount, EtherDecimals),
 	}
 	return
 }
 
 func (c *BlockchainClient) DeploySecurityToken(ctx context.Context, req data.DeploySTRequest) (resp data.DeploySTResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	c.defaultCompliance(&req.ComplianceAddress)
+	ctx, call := c.begin(ctx, "DeploySecurityToken", data.RequestType_DEPLOY_ST, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
 		return
 	}
 
 	var (
-		initalSupply, _   = data.ToWei(req.GetInitialSupply(), 18)
+		initalSupply, _   = data.ToWei(req.GetInitialSupply(), DefaultDecimals)
 		complianceAddress = common.HexToAddress(req.GetComplianceAddress())
-		input, _          = c.stABI.Pack("", []interface{}{req.GetName(), req.GetSymbol(), initalSupply, complianceAddress}...)
 		bytecode          = common.FromHex(contract.SecurityTokenBin)
 	)
-	hash, err := c.ethclient.SyncSend(ctx, req.GetPrivateKey(), nil, nil, append(bytecode, input...), 0)
+	input, err := pack(&c.stABI.ABI, "", req.GetName(), req.GetSymbol(), initalSupply, complianceAddress)
 	if err != nil {
-		err = errors.Wrap(err, "failed sync send deploy transaction")
 		return
 	}
 
-	receipt, err := c.ethclient.Receipt(ctx, hash)
+	hash, receipt, err := c.syncSend(ctx, call, req.GetPrivateKey(), nil, nil, append(bytecode, input...), 0)
 	if err != nil {
-		err = errors.Wrapf(err, "failed to get the receipt of deployed transaction(=%s)", hash)
+		err = errors.Wrap(err, "failed sync send deploy transaction")
+		resp.Hash = hash
 		return
 	}
 
@@ -166,18 +414,21 @@ func (c *BlockchainClient) DeploySecurityToken(ctx context.Context, req data.Dep
 }
 
 func (c *BlockchainClient) DeployComplianceService(ctx context.Context, req data.DeployCSRequest) (resp data.DeployCSResponse, err error) {
+	ctx, call := c.begin(ctx, "DeployComplianceService", data.RequestType_DEPLOY_CS, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
 	var (
 		bytecode = common.FromHex(contract.ComplianceServiceBin)
 	)
-	hash, err := c.ethclient.SyncSend(ctx, req.GetPrivateKey(), nil, nil, bytecode, 0)
+	hash, receipt, err := c.syncSend(ctx, call, req.GetPrivateKey(), nil, nil, bytecode, 0)
 	if err != nil {
 		err = errors.Wrap(err, "failed sync send deploy transaction")
-		return
-	}
-
-	receipt, err := c.ethclient.Receipt(ctx, hash)
-	if err != nil {
-		err = errors.Wrapf(err, "failed to get the receipt of deployed transaction(=%s)", hash)
+		resp.Hash = hash
 		return
 	}
 
@@ -191,20 +442,32 @@ func (c *BlockchainClient) DeployComplianceService(ctx context.Context, req data
 }
 
 func (c *BlockchainClient) IssueSecurityToken(ctx context.Context, req data.IssueRequest) (resp data.IssueResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	ctx, call := c.begin(ctx, "IssueSecurityToken", data.RequestType_ISSUE, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	contractAddress := common.HexToAddress(req.GetContractAddress())
+	amount, err := c.toBaseUnits(ctx, contractAddress, req.GetAmount())
+	if err != nil {
 		return
 	}
 
 	var (
-		amount, _       = data.ToWei(req.GetAmount(), 18)
-		contractAddress = common.HexToAddress(req.GetContractAddress())
-		recipient       = common.HexToAddress(req.GetRecipient())
-		input, _        = c.stABI.Pack("issue", []interface{}{recipient, amount}...)
+		recipient = common.HexToAddress(req.GetRecipient())
 	)
-	hash, err := c.send(ctx, req.GetPrivateKey(), &contractAddress, nil, input, req.GetGasLimit(), req.GetIsAsync())
+	input, err := pack(&c.stABI.ABI, "issue", recipient, amount)
+	if err != nil {
+		return
+	}
+
+	hash, err := c.send(ctx, call, req.GetPrivateKey(), &contractAddress, nil, input, req.GetGasLimit(), req.GetIsAsync())
 	if err != nil {
 		err = errors.Wrapf(err, "faile to send token issue transaction. contract=%s", req.GetContractAddress())
+		resp.Hash = hash
 		return
 	}
 
@@ -217,21 +480,32 @@ func (c *BlockchainClient) IssueSecurityToken(ctx context.Context, req data.Issu
 }
 
 func (c *BlockchainClient) TransferSecurityToken(ctx context.Context, req data.TransferRequest) (resp data.TransferResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	ctx, call := c.begin(ctx, "TransferSecurityToken", data.RequestType_TRANSFER, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	contractAddress := common.HexToAddress(req.GetContractAddress())
+	amount, err := c.toBaseUnits(ctx, contractAddress, req.GetAmount())
+	if err != nil {
 		return
 	}
 
 	var (
-		amount, _       = data.ToWei(req.GetAmount(), 18)
-		contractAddress = common.HexToAddress(req.GetContractAddress())
-		recipient       = common.HexToAddress(req.GetRecipient())
-		input, _        = c.stABI.Pack("transfer", []interface{}{recipient, amount}...)
+		recipient = common.HexToAddress(req.GetRecipient())
 	)
+	input, err := pack(&c.stABI.ABI, "transfer", recipient, amount)
+	if err != nil {
+		return
+	}
 
-	hash, err := c.send(ctx, req.GetPrivateKey(), &contractAddress, nil, input, req.GetGasLimit(), req.GetIsAsync())
+	hash, err := c.send(ctx, call, req.GetPrivateKey(), &contractAddress, nil, input, req.GetGasLimit(), req.GetIsAsync())
 	if err != nil {
 		err = errors.Wrapf(err, "faile to send token transfer transaction. contract=%s", req.GetContractAddress())
+		resp.Hash = hash
 		return
 	}
 
@@ -244,20 +518,32 @@ func (c *BlockchainClient) TransferSecurityToken(ctx context.Context, req data.T
 }
 
 func (c *BlockchainClient) BurnSecurityToken(ctx context.Context, req data.RedeemRequest) (resp data.RedeemResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	ctx, call := c.begin(ctx, "BurnSecurityToken", data.RequestType_REDEEM, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	contractAddress := common.HexToAddress(req.GetContractAddress())
+	amount, err := c.toBaseUnits(ctx, contractAddress, req.GetAmount())
+	if err != nil {
 		return
 	}
 
 	var (
-		amount, _       = data.ToWei(req.GetAmount(), 18)
-		contractAddress = common.HexToAddress(req.GetContractAddress())
-		account         = common.HexToAddress(req.GetAccount())
-		input, _        = c.stABI.Pack("redeem", []interface{}{account, amount, req.GetReason()}...)
+		account = common.HexToAddress(req.GetAccount())
 	)
-	hash, err := c.send(ctx, req.GetPrivateKey(), &contractAddress, nil, input, 0, false)
+	input, err := pack(&c.stABI.ABI, "redeem", account, amount, req.GetReason())
+	if err != nil {
+		return
+	}
+
+	hash, err := c.send(ctx, call, req.GetPrivateKey(), &contractAddress, nil, input, 0, false)
 	if err != nil {
 		err = errors.Wrapf(err, "faile to send token burn transaction. contract=%s", req.GetContractAddress())
+		resp.Hash = hash
 		return
 	}
 
@@ -270,19 +556,28 @@ func (c *BlockchainClient) BurnSecurityToken(ctx context.Context, req data.Redee
 }
 
 func (c *BlockchainClient) RegisterWalletComplianceService(ctx context.Context, req data.RegisterWalletRequest) (resp data.RegisterWalletResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	c.defaultCompliance(&req.ContractAddress)
+	ctx, call := c.begin(ctx, "RegisterWalletComplianceService", data.RequestType_REGISTER_WALLET, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
 		return
 	}
 
 	var (
 		contractAddress = common.HexToAddress(req.GetContractAddress())
 		account         = common.HexToAddress(req.GetAccount())
-		input, _        = c.csABI.Pack("registerWallet", []interface{}{account}...)
 	)
-	hash, err := c.send(ctx, req.GetPrivateKey(), &contractAddress, nil, input, req.GetGasLimit(), req.GetIsAsync())
+	input, err := pack(&c.csABI.ABI, "registerWallet", account)
+	if err != nil {
+		return
+	}
+
+	hash, err := c.send(ctx, call, req.GetPrivateKey(), &contractAddress, nil, input, req.GetGasLimit(), req.GetIsAsync())
 	if err != nil {
 		err = errors.Wrapf(err, "faile to send register wallet transaction. contract=%s", req.GetContractAddress())
+		resp.Hash = hash
 		return
 	}
 
@@ -295,8 +590,12 @@ func (c *BlockchainClient) RegisterWalletComplianceService(ctx context.Context,
 }
 
 func (c *BlockchainClient) GrantRole(ctx context.Context, req data.GrantRoleRequest) (resp data.GrantRoleResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	c.defaultCompliance(&req.ContractAddress)
+	ctx, call := c.begin(ctx, "GrantRole", data.RequestType_GRANT_ROLE, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
 		return
 	}
 
@@ -307,14 +606,23 @@ func (c *BlockchainClient) GrantRole(ctx context.Context, req data.GrantRoleRequ
 	var (
 		contractAddress = common.HexToAddress(req.GetContractAddress())
 		grantee         = common.HexToAddress(req.GetGrantee())
-		input, _        = c.csABI.Pack("setupRole", []interface{}{role, grantee}...)
 	)
-	hash, err := c.ethclient.SyncSend(ctx, req.GetPrivateKey(), &contractAddress, nil, input, 0)
+	input, err := pack(&c.csABI.ABI, "setupRole", role, grantee)
+	if err != nil {
+		return
+	}
+
+	hash, _, err := c.syncSend(ctx, call, req.GetPrivateKey(), &contractAddress, nil, input, 0)
 	if err != nil {
 		err = errors.Wrapf(err, "failed sync send grant role transaction. contract=%s", req.GetContractAddress())
+		resp.Hash = hash
 		return
 	}
 
+	if c.cache != nil {
+		c.cache.invalidate(contractAddress, "hasRole")
+	}
+
 	c.logger.Info().Msgf("wallet registerd, role=%s, grantee=%s contract=%s", req.GetRole(), req.GetGrantee(), req.GetContractAddress())
 
 	resp = data.GrantRoleResponse{
@@ -324,25 +632,27 @@ func (c *BlockchainClient) GrantRole(ctx context.Context, req data.GrantRoleRequ
 }
 
 func (c *BlockchainClient) NameSecurityToken(ctx context.Context, req data.NameRequest) (resp data.NameResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	ctx, call := c.begin(ctx, "NameSecurityToken", data.RequestType_NAME, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
 		return
 	}
 
-	var (
-		contractAddress = common.HexToAddress(req.GetContractAddress())
-		input, _        = c.stABI.Pack("name", []interface{}{}...)
-	)
-	output, err := c.ethclient.QueryContract(ctx, contractAddress, input)
+	block, err := c.blockAt(ctx, req.GetBlock())
 	if err != nil {
-		err = errors.Wrapf(err, "failed to query contract(=%s), input(=%v)", contractAddress.String(), input)
 		return
 	}
 
 	var (
-		results, _ = c.stABI.Unpack("name", output)
-		name       = *abi.ConvertType(results[0], new(string)).(*string)
+		contractAddress = common.HexToAddress(req.GetContractAddress())
+		name            string
 	)
+	if err = c.query(ctx, c.stABI, contractAddress, block, &name, "name"); err != nil {
+		return
+	}
+
 	resp = data.NameResponse{
 		Name: name,
 	}
@@ -350,25 +660,27 @@ func (c *BlockchainClient) NameSecurityToken(ctx context.Context, req data.NameR
 }
 
 func (c *BlockchainClient) SymbolSecurityToken(ctx context.Context, req data.SymbolRequest) (resp data.SymbolResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	ctx, call := c.begin(ctx, "SymbolSecurityToken", data.RequestType_SYMBOL, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
 		return
 	}
 
-	var (
-		contractAddress = common.HexToAddress(req.GetContractAddress())
-		input, _        = c.stABI.Pack("symbol", []interface{}{}...)
-	)
-	output, err := c.ethclient.QueryContract(ctx, contractAddress, input)
+	block, err := c.blockAt(ctx, req.GetBlock())
 	if err != nil {
-		err = errors.Wrapf(err, "failed to query contract(=%s), input(=%v)", contractAddress.String(), input)
 		return
 	}
 
 	var (
-		results, _ = c.stABI.Unpack("symbol", output)
-		symbol     = *abi.ConvertType(results[0], new(string)).(*string)
+		contractAddress = common.HexToAddress(req.GetContractAddress())
+		symbol          string
 	)
+	if err = c.query(ctx, c.stABI, contractAddress, block, &symbol, "symbol"); err != nil {
+		return
+	}
+
 	resp = data.SymbolResponse{
 		Symbol: symbol,
 	}
@@ -376,61 +688,88 @@ func (c *BlockchainClient) SymbolSecurityToken(ctx context.Context, req data.Sym
 }
 
 func (c *BlockchainClient) TotalSupplySecurityToken(ctx context.Context, req data.TotalSupplyRequest) (resp data.TotalSupplyResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	ctx, call := c.begin(ctx, "TotalSupplySecurityToken", data.RequestType_TOTAL_SUPPLY, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	block, err := c.blockAt(ctx, req.GetBlock())
+	if err != nil {
 		return
 	}
 
 	var (
 		contractAddress = common.HexToAddress(req.GetContractAddress())
-		input, _        = c.stABI.Pack("totalSupply", []interface{}{}...)
+		amount          *big.Int
 	)
-	output, err := c.ethclient.QueryContract(ctx, contractAddress, input)
+	if err = c.query(ctx, c.stABI, contractAddress, block, &amount, "totalSupply"); err != nil {
+		return
+	}
+
+	decimals, err := c.tokenDecimals(ctx, contractAddress)
 	if err != nil {
-		err = errors.Wrapf(err, "failed to query contract(=%s), input(=%v)", contractAddress.String(), input)
 		return
 	}
 
-	var (
-		results, _ = c.stABI.Unpack("totalSupply", output)
-		amount     = *abi.ConvertType(results[0], new(*big.Int)).(**big.Int)
-	)
 	resp = data.TotalSupplyResponse{
-		Amount: amount.String(),
+		Amount:          amount.String(),
+		Decimals:        uint32(decimals),
+		FormattedAmount: data.FromWei(amount, int(decimals)),
 	}
 	return
 }
 
 func (c *BlockchainClient) BalanceOfSecurityToken(ctx context.Context, req data.BalanceOfRequest) (resp data.BalanceOfResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	ctx, call := c.begin(ctx, "BalanceOfSecurityToken", data.RequestType_BALANCE_OF, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	block, err := c.blockAt(ctx, req.GetBlock())
+	if err != nil {
 		return
 	}
 
 	var (
 		contractAddress = common.HexToAddress(req.GetContractAddress())
 		acount          = common.HexToAddress(req.GetAccount())
-		input, _        = c.stABI.Pack("balanceOf", []interface{}{acount}...)
+		amount          *big.Int
 	)
-	output, err := c.ethclient.QueryContract(ctx, contractAddress, input)
+	if err = c.query(ctx, c.stABI, contractAddress, block, &amount, "balanceOf", acount); err != nil {
+		return
+	}
+
+	decimals, err := c.tokenDecimals(ctx, contractAddress)
 	if err != nil {
-		err = errors.Wrapf(err, "failed to query contract(=%s), input(=%v)", contractAddress.String(), input)
 		return
 	}
 
-	var (
-		results, _ = c.stABI.Unpack("balanceOf", output)
-		amount     = *abi.ConvertType(results[0], new(*big.Int)).(**big.Int)
-	)
 	resp = data.BalanceOfResponse{
-		Amount: amount.String(),
+		Amount:          amount.String(),
+		Decimals:        uint32(decimals),
+		FormattedAmount: data.FromWei(amount, int(decimals)),
 	}
 	return
 }
 
 func (c *BlockchainClient) HasRole(ctx context.Context, req data.HasRoleRequest) (resp data.HasRoleResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	c.defaultCompliance(&req.ContractAddress)
+	ctx, call := c.begin(ctx, "HasRole", data.RequestType_HAS_ROLE, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	block, err := c.blockAt(ctx, req.GetBlock())
+	if err != nil {
 		return
 	}
 
@@ -441,37 +780,64 @@ func (c *BlockchainClient) HasRole(ctx context.Context, req data.HasRoleRequest)
 	var (
 		contractAddress = common.HexToAddress(req.GetContractAddress())
 		acount          = common.HexToAddress(req.GetAccount())
-		input, _        = c.csABI.Pack("hasRole", []interface{}{role, acount}...)
+		has             bool
 	)
-	output, err := c.ethclient.QueryContract(ctx, contractAddress, input)
+	if err = c.query(ctx, c.csABI, contractAddress, block, &has, "hasRole", role, acount); err != nil {
+		return
+	}
+
+	resp = data.HasRoleResponse{
+		Has: has,
+	}
+	return
+}
+
+func (c *BlockchainClient) ContainsWallet(ctx context.Context, req data.ContainsWalletRequest) (resp data.ContainsWalletResponse, err error) {
+	c.defaultCompliance(&req.ContractAddress)
+	ctx, call := c.begin(ctx, "ContainsWallet", data.RequestType_CONTAINS_WALLET, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	block, err := c.blockAt(ctx, req.GetBlock())
 	if err != nil {
-		err = errors.Wrapf(err, "failed to query contract(=%s), input(=%v)", contractAddress.String(), input)
 		return
 	}
 
 	var (
-		results, _ = c.csABI.Unpack("hasRole", output)
-		has        = *abi.ConvertType(results[0], new(bool)).(*bool)
+		contractAddress = common.HexToAddress(req.GetContractAddress())
+		acount          = common.HexToAddress(req.GetAccount())
+		registered      bool
 	)
-	resp = data.HasRoleResponse{
-		Has: has,
+	if err = c.query(ctx, c.csABI, contractAddress, block, &registered, "containsWallet", acount); err != nil {
+		return
+	}
+
+	resp = data.ContainsWalletResponse{
+		Registered: registered,
 	}
 	return
 }
 
 func (c *BlockchainClient) DeployFactory(ctx context.Context, req data.DeployFCRequest) (resp data.DeployFCResponse, err error) {
+	ctx, call := c.begin(ctx, "DeployFactory", data.RequestType_DEPLOY_FC, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
 	var (
 		bytecode = common.FromHex(contract.FactoryV0Bin)
 	)
-	hash, err := c.ethclient.SyncSend(ctx, req.GetPrivateKey(), nil, nil, bytecode, 0)
+	hash, receipt, err := c.syncSend(ctx, call, req.GetPrivateKey(), nil, nil, bytecode, 0)
 	if err != nil {
 		err = errors.Wrap(err, "failed sync send deploy transaction")
-		return
-	}
-
-	receipt, err := c.ethclient.Receipt(ctx, hash)
-	if err != nil {
-		err = errors.Wrapf(err, "failed to get the receipt of deployed transaction(=%s)", hash)
+		resp.Hash = hash
 		return
 	}
 
@@ -485,8 +851,12 @@ func (c *BlockchainClient) DeployFactory(ctx context.Context, req data.DeployFCR
 }
 
 func (c *BlockchainClient) CreateContracts(ctx context.Context, req data.CreateContractsRequest) (resp data.CreateContractsResponse, err error) {
-	if err = req.Validate(); err != nil {
-		err = errors.Wrap(err, "at Validate")
+	c.defaultFactory(&req.ContractAddress)
+	ctx, call := c.begin(ctx, "CreateContracts", data.RequestType_CREATE_CONTRACTS, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
 		return
 	}
 
@@ -496,25 +866,25 @@ func (c *BlockchainClient) CreateContracts(ctx context.Context, req data.CreateC
 	}
 
 	var (
-		initalSupply, _ = data.ToWei(req.GetInitialSupply(), 18)
+		initalSupply, _ = data.ToWei(req.GetInitialSupply(), DefaultDecimals)
 		contractAddress = common.HexToAddress(req.GetContractAddress())
-		input, _        = c.fcABI.Pack("create", []interface{}{req.GetName(), req.GetSymbol(), initalSupply, grantees}...)
 	)
-	hash, err := c.ethclient.SyncSend(ctx, req.GetPrivateKey(), &contractAddress, nil, input, 0)
+	input, err := pack(&c.fcABI.ABI, "create", req.GetName(), req.GetSymbol(), initalSupply, grantees)
 	if err != nil {
-		err = errors.Wrap(err, "failed sync send deploy transaction")
 		return
 	}
 
-	receipt, err := c.ethclient.Receipt(ctx, hash)
+	hash, receipt, err := c.syncSend(ctx, call, req.GetPrivateKey(), &contractAddress, nil, input, 0)
 	if err != nil {
-		err = errors.Wrapf(err, "failed to get the receipt of deployed transaction(=%s)", hash)
+		err = errors.Wrap(err, "failed sync send deploy transaction")
+		resp.Hash = hash
 		return
 	}
 
-	clog := contract.FactoryV0Created{}
-	if err = c.fcABI.UnpackIntoInterface(&clog, "Created", receipt.Logs[len(receipt.Logs)-1].Data); err != nil {
-		err = errors.Wrapf(err, "failed unpack log transaction(=%v)", receipt.Logs)
+	clog, err := c.createdEvent(contractAddress, receipt)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to extract created event from transaction(=%s)", hash)
+		resp.Hash = hash
 		return
 	}
 
@@ -528,22 +898,260 @@ func (c *BlockchainClient) CreateContracts(ctx context.Context, req data.CreateC
 	return
 }
 
-func (c *BlockchainClient) send(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64, isAsync bool) (hash string, err error) {
+// ListFactoryDeployments lists the tokens created by the factory from FromBlock up to Block, scanning the logs by pages of WithLogPageSize blocks.
+// The tokens are read at Block, pinned to its number when latest.
+func (c *BlockchainClient) ListFactoryDeployments(ctx context.Context, req data.ListFactoryDeploymentsRequest) (resp data.ListFactoryDeploymentsResponse, err error) {
+	c.defaultFactory(&req.ContractAddress)
+	ctx, call := c.begin(ctx, "ListFactoryDeployments", data.RequestType_LIST_FACTORY_DEPLOYMENTS, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	block, err := c.blockAt(ctx, req.GetBlock())
+	if err != nil {
+		return
+	}
+
+	// the last page ends at the latest mined block when reading pending
+	to := block
+	if block == nil || block.Cmp(PendingBlock) == 0 {
+		header, herr := c.backend.HeaderByNumber(ctx, nil)
+		if herr != nil {
+			err = errors.Wrap(herr, "failed to get the latest header")
+			return
+		}
+		to = header.Number
+		if block == nil {
+			block = to
+		}
+	}
+
+	contractAddress := common.HexToAddress(req.GetContractAddress())
+	filterer, err := contract.BindFactoryV0(contractAddress, nil)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to bind factory filterer. contract=%s", req.GetContractAddress())
+		return
+	}
+
+	timestamps := make(map[uint64]uint64)
+	for from := req.GetFromBlock(); from <= to.Uint64(); from += c.logPageSize {
+		last := from + c.logPageSize - 1
+		if last > to.Uint64() {
+			last = to.Uint64()
+		}
+
+		logs, ferr := c.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			Addresses: []common.Address{contractAddress},
+			Topics:    [][]common.Hash{{c.fcABI.Topics["Created"]}},
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(last),
+		})
+		if ferr != nil {
+			err = errors.Wrapf(ferr, "failed to filter created events. contract=%s, from=%d, to=%d", req.GetContractAddress(), from, last)
+			return
+		}
+
+		events := make([]*contract.FactoryV0Created, len(logs))
+		for i, log := range logs {
+			if events[i], err = filterer.ParseCreated(log); err != nil {
+				err = errors.Wrapf(err, "failed to parse created event. tx=%s", log.TxHash.String())
+				return
+			}
+		}
+
+		deployments, derr := c.factoryDeployments(ctx, events, block, timestamps)
+		if derr != nil {
+			err = derr
+			return
+		}
+		resp.Deployments = append(resp.Deployments, deployments...)
+	}
+	return
+}
+
+// factoryDeployments enriches the Created events of a page with the state of their tokens at block, read in two batches.
+// timestamps caches block times, as a factory often creates several tokens in the same block.
+func (c *BlockchainClient) factoryDeployments(ctx context.Context, events []*contract.FactoryV0Created, block *big.Int, timestamps map[uint64]uint64) ([]*data.FactoryDeployment, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	tokenMethods := []string{"name", "symbol", "totalSupply", "nowCompliance"}
+	calls := make([]Call, 0, len(events)*len(tokenMethods))
+	for _, event := range events {
+		for _, method := range tokenMethods {
+			input, err := pack(&c.stABI.ABI, method)
+			if err != nil {
+				return nil, err
+			}
+			calls = append(calls, Call{To: event.Token, Input: input})
+		}
+	}
+	results, err := c.BatchCall(ctx, calls, block)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		deployments = make([]*data.FactoryDeployment, len(events))
+		compliances = make([]Call, len(events))
+	)
+	for i, event := range events {
+		var (
+			name, symbol string
+			supply       *big.Int
+			compliance   common.Address
+			outs         = []interface{}{&name, &symbol, &supply, &compliance}
+		)
+		for j, method := range tokenMethods {
+			r := results[i*len(tokenMethods)+j]
+			if r.Err != nil {
+				return nil, errors.Wrapf(r.Err, "failed to query %s of deployment(=%s)", method, event.Token.String())
+			}
+			if err := unpack(&c.stABI.ABI, method, r.Output, outs[j]); err != nil {
+				return nil, errors.Wrapf(err, "deployment(=%s)", event.Token.String())
+			}
+		}
+
+		input, err := pack(&c.csABI.ABI, "paused")
+		if err != nil {
+			return nil, err
+		}
+		compliances[i] = Call{To: compliance, Input: input}
+
+		number := event.Raw.BlockNumber
+		timestamp, ok := timestamps[number]
+		if !ok {
+			header, err := c.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get the header of block(=%d)", number)
+			}
+			timestamp = header.Time
+			timestamps[number] = timestamp
+		}
+
+		deployments[i] = &data.FactoryDeployment{
+			Creator:           event.Creator.String(),
+			ComplianceAddress: event.Compliance.String(),
+			TokenAddress:      event.Token.String(),
+			Hash:              event.Raw.TxHash.String(),
+			BlockNumber:       number,
+			Timestamp:         timestamp,
+			Name:              name,
+			Symbol:            symbol,
+			TotalSupply:       supply.String(),
+		}
+	}
+
+	if results, err = c.BatchCall(ctx, compliances, block); err != nil {
+		return nil, err
+	}
+	for i, r := range results {
+		if r.Err != nil {
+			return nil, errors.Wrapf(r.Err, "failed to query paused of compliance(=%s)", compliances[i].To.String())
+		}
+		if err := unpack(&c.csABI.ABI, "paused", r.Output, &deployments[i].Paused); err != nil {
+			return nil, errors.Wrapf(err, "compliance(=%s)", compliances[i].To.String())
+		}
+	}
+	return deployments, nil
+}
+
+// syncSend sends the transaction, then waits until it is confirmed, polling its receipt every sync send confirm interval.
+// It fails with ErrTxReverted if the execution did not succeed, and returns the hash also when failing once sent.
+func (c *BlockchainClient) syncSend(ctx context.Context, cl *call, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (hash string, receipt *types.Receipt, err error) {
+	if hash, err = c.backend.AsyncSend(ctx, priv, to, amount, input, gasLimit); err != nil {
+		return
+	}
+	cl.hash = hash
+
+	ticker := time.NewTicker(time.Duration(c.confirmer.syncSendInterval) * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			err = errors.Wrapf(eclient.ErrSyncSendTimeout, "transaction(=%s) not confirmed: %s", hash, ctx.Err())
+			return
+		case <-ticker.C:
+		}
+
+		if receipt, err = c.receipt(ctx, hash); err != nil {
+			if errors.Is(err, ErrTxReverted) {
+				return
+			}
+			continue
+		}
+
+		if n := *c.confirmer.confirmationBlock; n > 0 {
+			header, herr := c.backend.HeaderByNumber(ctx, nil)
+			if herr != nil || receipt.BlockNumber.Uint64()+n > header.Number.Uint64() {
+				continue
+			}
+		}
+		cl.gasUsed = receipt.GasUsed
+		return
+	}
+}
+
+// receipt fetches the receipt of a mined transaction, failing with ErrTxReverted if the execution did not succeed.
+func (c *BlockchainClient) receipt(ctx context.Context, hash string) (receipt *types.Receipt, err error) {
+	if receipt, err = c.backend.Receipt(ctx, hash); err != nil {
+		return
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		err = errors.Wrapf(ErrTxReverted, "status=%d, block=%d", receipt.Status, receipt.BlockNumber)
+		return
+	}
+	return
+}
+
+// createdEvent finds the Created event emitted by the factory among the logs of the receipt.
+// Other contracts, such as the newly created ones, may emit logs in the same transaction, so the log position can't be relied on.
+func (c *BlockchainClient) createdEvent(factory common.Address, receipt *types.Receipt) (*contract.FactoryV0Created, error) {
+	filterer, err := contract.BindFactoryV0(factory, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to bind factory filterer. contract=%s", factory.String())
+	}
+
+	topic := c.fcABI.Topics["Created"]
+	for _, log := range receipt.Logs {
+		if log.Address != factory || len(log.Topics) == 0 || log.Topics[0] != topic {
+			continue
+		}
+		return filterer.ParseCreated(*log)
+	}
+
+	return nil, errors.Wrapf(ErrCreatedEventNotFound, "contract=%s, logs=%d", factory.String(), len(receipt.Logs))
+}
+
+// send returns the hash also when failing once the transaction is sent, as it may still be mined when the confirmation timed out.
+func (c *BlockchainClient) send(ctx context.Context, cl *call, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64, isAsync bool) (hash string, err error) {
+	cl.async = isAsync
+
 	if !isAsync {
-		if hash, err = c.ethclient.SyncSend(ctx, priv, to, amount, input, gasLimit); err != nil {
+		if hash, _, err = c.syncSend(ctx, cl, priv, to, amount, input, gasLimit); err != nil {
 			err = errors.Wrap(err, "failed sync sending")
 		}
 		return
 	}
 
-	if hash, err = c.ethclient.AsyncSend(ctx, priv, to, amount, input, gasLimit); err != nil {
+	if hash, err = c.backend.AsyncSend(ctx, priv, to, amount, input, gasLimit); err != nil {
 		err = errors.Wrap(err, "failed async sending")
 		return
 	}
+	cl.hash = hash
 
-	if err = c.ethclient.EnqueueTxHash(ctx, hash); err != nil {
+	if err = c.backend.EnqueueTxHash(ctx, hash); err != nil {
 		err = errors.Wrapf(err, "failed to enqueu async transaction(=%s)", hash)
 		return
 	}
+
+	c.tracker.add(hash, cl.method, trace.SpanContextFromContext(ctx))
+	c.metrics.txSent()
 	return
 }
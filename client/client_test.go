@@ -2,29 +2,69 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/ango-ya/chain-client/contract"
 	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 	eclient "github.com/tak1827/eth-extended-client/client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	// "go.uber.org/goleak"
 )
 
 const (
-	TestEndpoint             = "http://localhost:8545"
-	TestPrivKey              = "d1c71e71b06e248c8dbe94d49ef6d6b0d64f5d71b1e33a0f39e14dadb070304a"
-	TestAccount              = "0xE3b0DE0E4CA5D3CB29A9341534226C4D31C9838f"
-	TestPrivKey2             = "8179ce3d00ac1d1d1d38e4f038de00ccd0e0375517164ac5448e3acc847acb34"
-	TestAccount2             = "0x26fa9f1a6568b42e29b1787c403B3628dFC0C6FE"
-	TestPrivKey3             = "df38daebd09f56398cc8fd699b72f5ea6e416878312e1692476950f427928e7d"
-	TestAccount3             = "0x31a6EE302c1E7602685c86EF7a3069210Bc26670"
-	TestPrivKey4             = "97d12403ffc2faa3660730ae58bca14a894ebd78b4d8207d22083554ae96be5c"
-	TestAccount4             = "0xa52ce7A3B18095800ed1f550065DF9Cd5ca5ce9f"
-	TestComplianceAddress    = "0xe868feADdAA8965b6e64BDD50a14cD41e3D5245D"
-	TestSecurityTokenAddress = "0xA7E7717817776181f64b46f9e4EFC75e181f9Dce"
+	TestPrivKey  = "d1c71e71b06e248c8dbe94d49ef6d6b0d64f5d71b1e33a0f39e14dadb070304a"
+	TestAccount  = "0xE3b0DE0E4CA5D3CB29A9341534226C4D31C9838f"
+	TestPrivKey2 = "8179ce3d00ac1d1d1d38e4f038de00ccd0e0375517164ac5448e3acc847acb34"
+	TestAccount2 = "0x26fa9f1a6568b42e29b1787c403B3628dFC0C6FE"
+	TestPrivKey3 = "df38daebd09f56398cc8fd699b72f5ea6e416878312e1692476950f427928e7d"
+	TestAccount3 = "0x31a6EE302c1E7602685c86EF7a3069210Bc26670"
+	TestPrivKey4 = "97d12403ffc2faa3660730ae58bca14a894ebd78b4d8207d22083554ae96be5c"
+	TestAccount4 = "0xa52ce7A3B18095800ed1f550065DF9Cd5ca5ce9f"
 )
 
+// set up by TestMain against an in-process simulated chain
+var (
+	TestEndpoint             string
+	TestComplianceAddress    string
+	TestSecurityTokenAddress string
+)
+
+func TestMain(m *testing.M) {
+	chain, err := NewSimulatedChain()
+	if err != nil {
+		panic(err)
+	}
+
+	TestEndpoint = chain.URL()
+	TestComplianceAddress = chain.ComplianceAddress.String()
+	TestSecurityTokenAddress = chain.SecurityTokenAddress.String()
+
+	code := m.Run()
+
+	chain.Close()
+	os.Exit(code)
+}
+
 func TestShutdown(t *testing.T) {
 	c, err := NewBlockchainClient(TestEndpoint, WithTimeout(3))
 	require.NoError(t, err)
@@ -60,6 +100,7 @@ func TestETH(t *testing.T) {
 	bRes, err := c.BalanceOfETH(ctx, bReq)
 	require.NoError(t, err)
 	require.Equal(t, expected, bRes.GetAmount())
+	require.Equal(t, req.GetAmount(), bRes.GetFormattedAmount())
 }
 
 func TestDeploySecurityToken(t *testing.T) {
@@ -95,6 +136,54 @@ func TestDeploySecurityToken(t *testing.T) {
 	require.Equal(t, req.GetName(), nameRes.GetName())
 	require.Equal(t, req.GetSymbol(), symRes.GetSymbol())
 	require.Equal(t, expected.String(), supRes.GetAmount())
+	require.Equal(t, uint32(18), supRes.GetDecimals())
+	require.Equal(t, req.GetInitialSupply(), supRes.GetFormattedAmount())
+}
+
+func TestGetTokenInfo(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		c, _   = NewBlockchainClient(TestEndpoint, WithTimeout(3))
+		supReq = data.TotalSupplyRequest{ContractAddress: TestSecurityTokenAddress}
+	)
+	c.Start()
+	defer c.Close()
+
+	latest, err := c.LatestBlockNumber(ctx)
+	require.NoError(t, err)
+
+	info, err := c.GetTokenInfo(ctx, data.TokenInfoRequest{ContractAddress: TestSecurityTokenAddress})
+	require.NoError(t, err)
+
+	nameRes, err := c.NameSecurityToken(ctx, data.NameRequest(supReq))
+	require.NoError(t, err)
+	symRes, err := c.SymbolSecurityToken(ctx, data.SymbolRequest(supReq))
+	require.NoError(t, err)
+	supRes, err := c.TotalSupplySecurityToken(ctx, supReq)
+	require.NoError(t, err)
+	require.Equal(t, nameRes.GetName(), info.GetName())
+	require.Equal(t, symRes.GetSymbol(), info.GetSymbol())
+	require.Equal(t, supRes.GetDecimals(), info.GetDecimals())
+	require.Equal(t, supRes.GetAmount(), info.GetTotalSupply())
+	require.Equal(t, supRes.GetFormattedAmount(), info.GetFormattedTotalSupply())
+
+	// コンプライアンスサービスの状態
+	require.Equal(t, TestComplianceAddress, info.GetComplianceAddress())
+	require.False(t, info.GetPaused())
+	require.False(t, info.GetTransferPaused())
+	require.GreaterOrEqual(t, info.GetWalletCount(), uint64(2))
+
+	// 問い合わせ開始時点のブロック
+	require.GreaterOrEqual(t, info.GetBlockNumber(), latest)
+
+	// コントラクトでないアドレスはエラー
+	_, err = c.GetTokenInfo(ctx, data.TokenInfoRequest{ContractAddress: TestAccount})
+	require.Error(t, err)
+
+	// pendingは読み込みごとに状態が変わりうるので受け付けない
+	_, err = c.GetTokenInfo(ctx, data.TokenInfoRequest{ContractAddress: TestSecurityTokenAddress, Block: data.BlockPending})
+	require.ErrorIs(t, err, data.ErrPendingBlock)
+	require.Equal(t, CategoryValidation, ErrorCategory(err))
 }
 
 func TestIssueTransferSecurityToken(t *testing.T) {
@@ -132,6 +221,7 @@ func TestIssueTransferSecurityToken(t *testing.T) {
 	balRes, err := c.BalanceOfSecurityToken(ctx, balReq)
 	require.NoError(t, err)
 	require.Equal(t, expected.String(), balRes.GetAmount())
+	require.Equal(t, "100", balRes.GetFormattedAmount())
 
 	// トークンの移転
 	// req.Account = TestAccount4
@@ -153,6 +243,120 @@ func TestIssueTransferSecurityToken(t *testing.T) {
 	require.Equal(t, expected.String(), balRes.GetAmount())
 }
 
+func TestHistoricalQueries(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		c, _   = NewBlockchainClient(TestEndpoint, WithTimeout(3))
+		balReq = data.BalanceOfRequest{ContractAddress: TestSecurityTokenAddress, Account: TestAccount4}
+		supReq = data.TotalSupplyRequest{ContractAddress: TestSecurityTokenAddress}
+	)
+	c.Start()
+	defer c.Close()
+
+	before, err := c.LatestBlockNumber(ctx)
+	require.NoError(t, err)
+	balBefore, err := c.BalanceOfSecurityToken(ctx, balReq)
+	require.NoError(t, err)
+	supBefore, err := c.TotalSupplySecurityToken(ctx, supReq)
+	require.NoError(t, err)
+
+	_, err = c.IssueSecurityToken(ctx, data.IssueRequest{
+		PrivateKey:      TestPrivKey2,
+		ContractAddress: TestSecurityTokenAddress,
+		Recipient:       TestAccount4,
+		Amount:          "10",
+	})
+	require.NoError(t, err)
+
+	// 発行前のブロックでは発行前の残高
+	balReq.Block = fmt.Sprint(before)
+	balRes, err := c.BalanceOfSecurityToken(ctx, balReq)
+	require.NoError(t, err)
+	require.Equal(t, balBefore.GetAmount(), balRes.GetAmount())
+
+	supReq.Block = hexutil.EncodeUint64(before)
+	supRes, err := c.TotalSupplySecurityToken(ctx, supReq)
+	require.NoError(t, err)
+	require.Equal(t, supBefore.GetAmount(), supRes.GetAmount())
+
+	info, err := c.GetTokenInfo(ctx, data.TokenInfoRequest{ContractAddress: TestSecurityTokenAddress, Block: fmt.Sprint(before)})
+	require.NoError(t, err)
+	require.Equal(t, before, info.GetBlockNumber())
+	require.Equal(t, supBefore.GetAmount(), info.GetTotalSupply())
+
+	// シミュレータではすべてのブロックが確定済み
+	for _, tag := range []string{"", data.BlockLatest, data.BlockPending, data.BlockSafe, data.BlockFinalized} {
+		balReq.Block = tag
+		balRes, err = c.BalanceOfSecurityToken(ctx, balReq)
+		require.NoError(t, err, tag)
+		require.NotEqual(t, balBefore.GetAmount(), balRes.GetAmount(), tag)
+	}
+
+	// 最初のブロックにはコントラクトがない
+	balReq.Block = data.BlockEarliest
+	_, err = c.BalanceOfSecurityToken(ctx, balReq)
+	require.Error(t, err)
+	ethRes, err := c.BalanceOfETH(ctx, data.BalanceOfETHRequest{Account: TestAccount, Block: data.BlockEarliest})
+	require.NoError(t, err)
+	require.Equal(t, TestBalance.String(), ethRes.GetAmount())
+
+	// ロールとウォレットの登録 (ブロック1でデプロイ、2でロール付与、3と4でウォレット登録)
+	roleReq := data.HasRoleRequest{ContractAddress: TestComplianceAddress, Role: data.ST_CONTROL_ROLE, Account: TestAccount2, Block: "1"}
+	roleRes, err := c.HasRole(ctx, roleReq)
+	require.NoError(t, err)
+	require.False(t, roleRes.GetHas())
+	roleReq.Block = ""
+	roleRes, err = c.HasRole(ctx, roleReq)
+	require.NoError(t, err)
+	require.True(t, roleRes.GetHas())
+
+	walletReq := data.ContainsWalletRequest{ContractAddress: TestComplianceAddress, Account: TestAccount4, Block: "3"}
+	walletRes, err := c.ContainsWallet(ctx, walletReq)
+	require.NoError(t, err)
+	require.False(t, walletRes.GetRegistered())
+	walletReq.Block = "4"
+	walletRes, err = c.ContainsWallet(ctx, walletReq)
+	require.NoError(t, err)
+	require.True(t, walletRes.GetRegistered())
+
+	// 不正なブロック
+	balReq.Block = "head"
+	_, err = c.BalanceOfSecurityToken(ctx, balReq)
+	require.ErrorIs(t, err, data.ErrInvalidBlock)
+	require.Equal(t, CategoryValidation, ErrorCategory(err))
+}
+
+func TestBlockNumberAt(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		c, _ = NewBlockchainClient(TestEndpoint, WithTimeout(3))
+	)
+	latest, err := c.LatestBlockNumber(ctx)
+	require.NoError(t, err)
+
+	for number := uint64(0); number <= latest; number++ {
+		header, err := c.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		require.NoError(t, err)
+
+		// ブロックの時刻ちょうどと、次のブロックまでの間
+		found, err := c.BlockNumberAt(ctx, time.Unix(int64(header.Time), 0))
+		require.NoError(t, err)
+		require.Equal(t, number, found)
+		found, err = c.BlockNumberAt(ctx, time.Unix(int64(header.Time)+1, 0))
+		require.NoError(t, err)
+		require.Equal(t, number, found)
+	}
+
+	// 最新のブロックより後
+	found, err := c.BlockNumberAt(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, latest, found)
+
+	// 最初のブロックより前
+	_, err = c.BlockNumberAt(ctx, time.Unix(-1, 0))
+	require.ErrorIs(t, err, ErrBlockNotFound)
+}
+
 func TestComplianceService(t *testing.T) {
 	var (
 		ctx  = context.Background()
@@ -233,6 +437,652 @@ func TestFactory(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, hasRes.GetHas())
 	}
+
+	lRes, err := c.ListFactoryDeployments(ctx, data.ListFactoryDeploymentsRequest{
+		ContractAddress: res.GetContractAddress(),
+	})
+	require.NoError(t, err)
+	require.Len(t, lRes.GetDeployments(), 1)
+
+	var (
+		deployment  = lRes.GetDeployments()[0]
+		expected, _ = data.ToWei(cReq.GetInitialSupply(), 18)
+	)
+	require.Equal(t, TestAccount, deployment.GetCreator())
+	require.Equal(t, cRes.GetComplianceAddress(), deployment.GetComplianceAddress())
+	require.Equal(t, cRes.GetTokenAddress(), deployment.GetTokenAddress())
+	require.Equal(t, cRes.GetHash(), deployment.GetHash())
+	require.NotZero(t, deployment.GetTimestamp())
+	require.Equal(t, cReq.GetName(), deployment.GetName())
+	require.Equal(t, cReq.GetSymbol(), deployment.GetSymbol())
+	require.Equal(t, expected.String(), deployment.GetTotalSupply())
+	require.False(t, deployment.GetPaused())
+
+	// 開始ブロック以降をページごとに走査し、内部の読み取りは計測しない
+	metrics := NewMetrics()
+	lister, err := NewBlockchainClient(TestEndpoint, WithTimeout(3), WithMetrics(metrics), WithLogPageSize(1))
+	require.NoError(t, err)
+	lister.Start()
+	defer lister.Close()
+
+	lReq := data.ListFactoryDeploymentsRequest{ContractAddress: res.GetContractAddress(), FromBlock: deployment.GetBlockNumber() - 2}
+	lRes, err = lister.ListFactoryDeployments(ctx, lReq)
+	require.NoError(t, err)
+	require.Len(t, lRes.GetDeployments(), 1)
+	require.Equal(t, deployment, lRes.GetDeployments()[0])
+
+	lReq.FromBlock = deployment.GetBlockNumber() + 1
+	lRes, err = lister.ListFactoryDeployments(ctx, lReq)
+	require.NoError(t, err)
+	require.Empty(t, lRes.GetDeployments())
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Contains(t, rec.Body.String(), `method="ListFactoryDeployments"`)
+	require.NotContains(t, rec.Body.String(), `method="NameSecurityToken"`)
+
+	require.Panics(t, func() { WithLogPageSize(0) })
+}
+
+func TestCreatedEvent(t *testing.T) {
+	fcABI, err := contract.ParsedFactoryV0ABI()
+	require.NoError(t, err)
+
+	var (
+		c          = BlockchainClient{fcABI: fcABI}
+		factory    = common.HexToAddress(TestComplianceAddress)
+		creator    = common.HexToAddress(TestAccount)
+		compliance = common.HexToAddress(TestAccount2)
+		token      = common.HexToAddress(TestAccount3)
+		topic      = fcABI.Topics["Created"]
+	)
+	payload, err := fcABI.Events["Created"].Inputs.Pack(creator, compliance, token)
+	require.NoError(t, err)
+
+	// 他のコントラクトのログや別のイベントは無視される
+	receipt := &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		Logs: []*types.Log{
+			{Address: common.HexToAddress(TestSecurityTokenAddress), Topics: []common.Hash{topic}, Data: payload},
+			{Address: factory, Topics: []common.Hash{common.HexToHash("0x01")}, Data: payload},
+		},
+	}
+	_, err = c.createdEvent(factory, receipt)
+	require.ErrorIs(t, err, ErrCreatedEventNotFound)
+
+	_, err = c.createdEvent(factory, &types.Receipt{})
+	require.ErrorIs(t, err, ErrCreatedEventNotFound)
+
+	receipt.Logs = append([]*types.Log{{Address: factory, Topics: []common.Hash{topic}, Data: payload}}, receipt.Logs...)
+	clog, err := c.createdEvent(factory, receipt)
+	require.NoError(t, err)
+	require.Equal(t, creator, clog.Creator)
+	require.Equal(t, compliance, clog.Compliance)
+	require.Equal(t, token, clog.Token)
+}
+
+// stubBackend answers every call with the same output, or panics, the other methods are left unimplemented
+type stubBackend struct {
+	ChainBackend
+
+	output []byte
+	panics bool
+	calls  int
+}
+
+func (b *stubBackend) Start() {}
+func (b *stubBackend) Stop()  {}
+func (b *stubBackend) CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) ([]byte, error) {
+	b.calls++
+	if b.panics {
+		panic("stub backend")
+	}
+	return b.output, nil
+}
+
+func TestWithBackend(t *testing.T) {
+	stABI, err := abi.JSON(strings.NewReader(contract.SecurityTokenABI))
+	require.NoError(t, err)
+	output, err := stABI.Methods["name"].Outputs.Pack("Stub Token")
+	require.NoError(t, err)
+
+	var (
+		ctx     = context.Background()
+		backend = &stubBackend{output: output}
+	)
+	// エンドポイントには接続しない
+	c, err := NewBlockchainClient("http://localhost:0", WithBackend(backend))
+	require.NoError(t, err)
+	c.Start()
+	defer c.Close()
+
+	res, err := c.NameSecurityToken(ctx, data.NameRequest{ContractAddress: TestSecurityTokenAddress})
+	require.NoError(t, err)
+	require.Equal(t, "Stub Token", res.GetName())
+	require.Equal(t, 1, backend.calls)
+
+	// チェックサムを必須にする
+	c, err = NewBlockchainClient("http://localhost:0", WithBackend(backend), WithValidatePolicy(data.WithChecksum()))
+	require.NoError(t, err)
+	_, err = c.NameSecurityToken(ctx, data.NameRequest{ContractAddress: strings.ToLower(TestSecurityTokenAddress)})
+	require.ErrorIs(t, err, data.ErrChecksum)
+	require.Equal(t, 1, backend.calls)
+}
+
+func TestUnexpectedOutput(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		backend = &stubBackend{}
+		req     = data.BalanceOfRequest{ContractAddress: TestSecurityTokenAddress, Account: TestAccount}
+	)
+	c, err := NewBlockchainClient("http://localhost:0", WithBackend(backend))
+	require.NoError(t, err)
+
+	// 空の出力
+	_, err = c.NameSecurityToken(ctx, data.NameRequest{ContractAddress: TestSecurityTokenAddress})
+	require.ErrorIs(t, err, ErrUnexpectedOutput)
+	require.Equal(t, CategoryBadOutput, ErrorCategory(err))
+
+	// デコードできない出力
+	backend.output = []byte{0x01, 0x02, 0x03}
+	_, err = c.BalanceOfSecurityToken(ctx, req)
+	require.ErrorIs(t, err, ErrUnexpectedOutput)
+
+	res, err := c.BatchBalanceOf(ctx, data.BatchBalanceOfRequest{ContractAddress: TestSecurityTokenAddress, Accounts: []string{TestAccount}})
+	require.ErrorIs(t, err, ErrUnexpectedOutput)
+	require.Empty(t, res.GetBalances())
+
+	// パニックはエラーとして返す
+	backend.panics = true
+	_, err = c.BalanceOfSecurityToken(ctx, req)
+	require.ErrorIs(t, err, ErrPanic)
+	require.Equal(t, CategoryPanic, ErrorCategory(err))
+
+	results, err := c.BatchCall(ctx, []Call{{To: common.HexToAddress(TestSecurityTokenAddress)}}, nil)
+	require.NoError(t, err)
+	require.ErrorIs(t, results[0].Err, ErrPanic)
+}
+
+func TestNonContractAddress(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		addr, _ = eclient.GenerateAddr()
+	)
+	c, err := NewBlockchainClient(TestEndpoint, WithTimeout(3))
+	require.NoError(t, err)
+	c.Start()
+	defer c.Close()
+
+	_, err = c.NameSecurityToken(ctx, data.NameRequest{ContractAddress: addr.String()})
+	require.ErrorIs(t, err, bind.ErrNoCode)
+	require.Equal(t, CategoryNoContract, ErrorCategory(err))
+
+	_, err = c.BalanceOfSecurityToken(ctx, data.BalanceOfRequest{ContractAddress: addr.String(), Account: TestAccount, Block: "1"})
+	require.ErrorIs(t, err, bind.ErrNoCode)
+}
+
+// decimalsBackend serves a token with 2 decimals and records the sent input
+type decimalsBackend struct {
+	ChainBackend
+
+	stABI    abi.ABI
+	queries  int
+	lastSent []byte
+}
+
+func (b *decimalsBackend) Start() {}
+func (b *decimalsBackend) Stop()  {}
+func (b *decimalsBackend) CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) ([]byte, error) {
+	method, err := b.stABI.MethodById(input)
+	if err != nil {
+		return nil, err
+	}
+	if method.Name == "decimals" {
+		b.queries++
+		return method.Outputs.Pack(uint8(2))
+	}
+	return method.Outputs.Pack(big.NewInt(12345))
+}
+func (b *decimalsBackend) AsyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (string, error) {
+	b.lastSent = input
+	return "0x01", nil
+}
+func (b *decimalsBackend) Receipt(ctx context.Context, hash string) (*types.Receipt, error) {
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful, GasUsed: 21000}, nil
+}
+
+func BenchmarkNewBlockchainClient(b *testing.B) {
+	backend := &stubBackend{}
+	for i := 0; i < b.N; i++ {
+		if _, err := NewBlockchainClient("http://localhost:0", WithBackend(backend)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// deadlineBackend records the deadline of the calls, and blocks until it is reached when blocking
+type deadlineBackend struct {
+	ChainBackend
+
+	blocking bool
+	deadline time.Time
+}
+
+func (b *deadlineBackend) Start() {}
+func (b *deadlineBackend) Stop()  {}
+func (b *deadlineBackend) CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) ([]byte, error) {
+	b.deadline, _ = ctx.Deadline()
+	if b.blocking {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return nil, nil
+}
+func (b *deadlineBackend) AsyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (string, error) {
+	b.deadline, _ = ctx.Deadline()
+	return "", ErrTxReverted
+}
+
+func TestTimeouts(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		backend = &deadlineBackend{}
+		nameReq = data.NameRequest{ContractAddress: TestSecurityTokenAddress}
+	)
+	c1, err := NewBlockchainClient("http://localhost:0", WithBackend(backend), WithTimeout(10), WithRPCTimeout(1))
+	require.NoError(t, err)
+	c2, err := NewBlockchainClient("http://localhost:0", WithBackend(backend), WithRPCTimeout(2), WithConfirmTimeout(5))
+	require.NoError(t, err)
+
+	// クライアントごとに保持する
+	require.Equal(t, timeouts{dial: 8 * time.Second, rpc: time.Second, confirm: 10 * time.Second, methods: map[string]time.Duration{"OnboardInvestor": 22 * time.Second}}, c1.timeouts)
+	require.Equal(t, timeouts{dial: 8 * time.Second, rpc: 2 * time.Second, confirm: 5 * time.Second, methods: map[string]time.Duration{"OnboardInvestor": 14 * time.Second}}, c2.timeouts)
+	require.Equal(t, 5*time.Second, c2.tracker.timeout)
+
+	// 読み取りはRPCのタイムアウト
+	_, _ = c1.NameSecurityToken(ctx, nameReq)
+	require.WithinDuration(t, time.Now().Add(time.Second), backend.deadline, 100*time.Millisecond)
+	_, _ = c2.NameSecurityToken(ctx, nameReq)
+	require.WithinDuration(t, time.Now().Add(2*time.Second), backend.deadline, 100*time.Millisecond)
+
+	// 同期送信は承認待ちの分も含む
+	_, err = c2.GrantRole(ctx, data.GrantRoleRequest{PrivateKey: TestPrivKey, ContractAddress: TestComplianceAddress, Role: data.ST_EDIT_ROLE, Grantee: TestAccount2})
+	require.Error(t, err)
+	require.WithinDuration(t, time.Now().Add(7*time.Second), backend.deadline, 100*time.Millisecond)
+
+	// メソッドごとのタイムアウト
+	c3, err := NewBlockchainClient("http://localhost:0", WithBackend(backend), WithRPCTimeout(2), WithMethodTimeout("NameSecurityToken", 4), WithMethodTimeout("OnboardInvestor", 60))
+	require.NoError(t, err)
+	require.Equal(t, 60*time.Second, c3.timeouts.methods["OnboardInvestor"])
+	_, _ = c3.NameSecurityToken(ctx, nameReq)
+	require.WithinDuration(t, time.Now().Add(4*time.Second), backend.deadline, 100*time.Millisecond)
+	_, _ = c3.SymbolSecurityToken(ctx, data.SymbolRequest(nameReq))
+	require.WithinDuration(t, time.Now().Add(2*time.Second), backend.deadline, 100*time.Millisecond)
+
+	_, err = NewBlockchainClient("http://localhost:0", WithBackend(backend), WithMethodTimeout("Unknown", 1))
+	require.Error(t, err)
+	require.Panics(t, func() { WithMethodTimeout("NameSecurityToken", 0) })
+
+	// 呼び出し元の期限を優先する
+	deadline := time.Now().Add(time.Minute)
+	cctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	_, _ = c1.NameSecurityToken(cctx, nameReq)
+	require.Equal(t, deadline, backend.deadline)
+
+	// 期限を過ぎるとタイムアウトとして返す
+	backend.blocking = true
+	start := time.Now()
+	_, err = c1.NameSecurityToken(ctx, nameReq)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, CategoryTimeout, ErrorCategory(err))
+	require.InDelta(t, time.Second, time.Since(start), float64(200*time.Millisecond))
+}
+
+// miningBackend mines the sent transaction after a delay, and counts the receipt lookups
+type miningBackend struct {
+	ChainBackend
+
+	delay    time.Duration
+	sentAt   time.Time
+	receipts int32
+}
+
+func (b *miningBackend) Start() {}
+func (b *miningBackend) Stop()  {}
+func (b *miningBackend) AsyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (string, error) {
+	b.sentAt = time.Now()
+	return "0x01", nil
+}
+func (b *miningBackend) Receipt(ctx context.Context, hash string) (*types.Receipt, error) {
+	atomic.AddInt32(&b.receipts, 1)
+	if time.Since(b.sentAt) < b.delay {
+		return nil, ethereum.NotFound
+	}
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful, GasUsed: 21000, BlockNumber: big.NewInt(1)}, nil
+}
+
+func TestSyncSendConfirmInterval(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		fast = &miningBackend{delay: 200 * time.Millisecond}
+		slow = &miningBackend{delay: 200 * time.Millisecond}
+		req  = data.SendETHRequest{PrivateKey: TestPrivKey, Recipient: TestAccount2, Amount: "1"}
+	)
+	c1, err := NewBlockchainClient("http://localhost:0", WithBackend(fast), WithSyncSendConfirmInterval(10))
+	require.NoError(t, err)
+	c2, err := NewBlockchainClient("http://localhost:0", WithBackend(slow), WithSyncSendConfirmInterval(150))
+	require.NoError(t, err)
+
+	// 後から生成したクライアントの間隔に影響されない
+	_, err = c1.SendETH(ctx, req)
+	require.NoError(t, err)
+	_, err = c2.SendETH(ctx, req)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, atomic.LoadInt32(&fast.receipts), int32(10))
+	require.LessOrEqual(t, atomic.LoadInt32(&slow.receipts), int32(3))
+
+	// 承認されないまま期限を過ぎるとタイムアウトとして返す
+	fast.delay = time.Hour
+	cctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	res, err := c1.SendETH(cctx, req)
+	require.ErrorIs(t, err, eclient.ErrSyncSendTimeout)
+	require.Equal(t, CategoryTimeout, ErrorCategory(err))
+	require.Equal(t, "0x01", res.GetHash())
+}
+
+func TestConfirmerOptions(t *testing.T) {
+	ctx := context.Background()
+
+	c, err := NewBlockchainClient("http://localhost:0", WithBackend(&stubBackend{}), WithConfirmWorkers(4), WithConfirmWorkerInterval(10), WithConfirmationInterval(0), WithConfirmationBlock(3), WithSyncSendConfirmInterval(20))
+	require.NoError(t, err)
+	require.Equal(t, 4, c.confirmer.workers)
+	require.Equal(t, int64(10), c.confirmer.workerInterval)
+	require.Equal(t, int64(0), c.confirmer.confirmationInterval)
+	require.Equal(t, uint64(3), *c.confirmer.confirmationBlock)
+	require.Equal(t, int64(20), c.confirmer.syncSendInterval)
+
+	// 承認待ちのタイムアウトより長い間隔
+	_, err = NewBlockchainClient("http://localhost:0", WithBackend(&stubBackend{}), WithConfirmTimeout(1), WithConfirmationInterval(1))
+	require.Error(t, err)
+	_, err = NewBlockchainClient("http://localhost:0", WithBackend(&stubBackend{}), WithConfirmTimeout(1), WithSyncSendConfirmInterval(1000))
+	require.Error(t, err)
+
+	require.Panics(t, func() { WithConfirmWorkers(0) })
+	require.Panics(t, func() { WithConfirmWorkerInterval(0) })
+	require.Panics(t, func() { WithConfirmationInterval(-1) })
+	require.Panics(t, func() { WithSyncSendConfirmInterval(0) })
+
+	// 複数のワーカーで同期送信を承認する
+	c, err = NewBlockchainClient(TestEndpoint, WithTimeout(3), WithConfirmWorkers(4), WithConfirmWorkerInterval(10), WithConfirmationInterval(0), WithSyncSendConfirmInterval(20))
+	require.NoError(t, err)
+	c.Start()
+	defer c.Close()
+
+	for i := 0; i < 3; i++ {
+		addr, _ := eclient.GenerateAddr()
+		_, err = c.SendETH(ctx, data.SendETHRequest{PrivateKey: TestPrivKey2, Recipient: addr.String(), Amount: "0.001"})
+		require.NoError(t, err)
+	}
+}
+
+func TestTokenDecimals(t *testing.T) {
+	stABI, err := abi.JSON(strings.NewReader(contract.SecurityTokenABI))
+	require.NoError(t, err)
+
+	var (
+		ctx     = context.Background()
+		backend = &decimalsBackend{stABI: stABI}
+	)
+	c, err := NewBlockchainClient("http://localhost:0", WithBackend(backend))
+	require.NoError(t, err)
+
+	balRes, err := c.BalanceOfSecurityToken(ctx, data.BalanceOfRequest{ContractAddress: TestSecurityTokenAddress, Account: TestAccount})
+	require.NoError(t, err)
+	require.Equal(t, "12345", balRes.GetAmount())
+	require.Equal(t, uint32(2), balRes.GetDecimals())
+	require.Equal(t, "123.45", balRes.GetFormattedAmount())
+
+	supRes, err := c.TotalSupplySecurityToken(ctx, data.TotalSupplyRequest{ContractAddress: TestSecurityTokenAddress})
+	require.NoError(t, err)
+	require.Equal(t, "123.45", supRes.GetFormattedAmount())
+
+	// トークンの桁数で変換される
+	_, err = c.IssueSecurityToken(ctx, data.IssueRequest{
+		PrivateKey:      TestPrivKey,
+		ContractAddress: TestSecurityTokenAddress,
+		Recipient:       TestAccount3,
+		Amount:          "1.5",
+	})
+	require.NoError(t, err)
+	args, err := stABI.Methods["issue"].Inputs.Unpack(backend.lastSent[4:])
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(150), args[1])
+
+	// トークンの桁数を超える小数は拒否される
+	_, err = c.IssueSecurityToken(ctx, data.IssueRequest{
+		PrivateKey:      TestPrivKey,
+		ContractAddress: TestSecurityTokenAddress,
+		Recipient:       TestAccount3,
+		Amount:          "1.555",
+	})
+	require.ErrorIs(t, err, data.ErrTooManyDecimals)
+	require.Equal(t, CategoryValidation, ErrorCategory(err))
+
+	// decimalsはキャッシュされる
+	require.Equal(t, 1, backend.queries)
+}
+
+func TestMetrics(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		metrics = NewMetrics()
+		c, _    = NewBlockchainClient(TestEndpoint, WithTimeout(3), WithMetrics(metrics))
+	)
+	c.Start()
+	defer c.Close()
+
+	_, err := c.BalanceOfETH(ctx, data.BalanceOfETHRequest{Account: TestAccount})
+	require.NoError(t, err)
+
+	_, err = c.BalanceOfETH(ctx, data.BalanceOfETHRequest{Account: "invalid"})
+	require.Error(t, err)
+	require.Equal(t, CategoryValidation, ErrorCategory(err))
+
+	_, err = c.RegisterWalletComplianceService(ctx, data.RegisterWalletRequest{
+		PrivateKey:      TestPrivKey2,
+		ContractAddress: TestComplianceAddress,
+		Account:         TestAccount4,
+		IsAsync:         true,
+	})
+	require.NoError(t, err)
+
+	// 同期送信は承認時のレシートからガスを記録する
+	addr, _ := eclient.GenerateAddr()
+	_, err = c.SendETH(ctx, data.SendETHRequest{PrivateKey: TestPrivKey2, Recipient: addr.String(), Amount: "0.001"})
+	require.NoError(t, err)
+
+	// 非同期トランザクションの結果を待つ
+	time.Sleep(1 * time.Second)
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+
+	require.Contains(t, body, `chain_client_requests_total{method="BalanceOfETH"} 2`)
+	require.Contains(t, body, `chain_client_errors_total{category="validation",method="BalanceOfETH"} 1`)
+	require.Contains(t, body, `chain_client_confirmation_duration_seconds_count{method="RegisterWalletComplianceService",status="confirmed"} 1`)
+	require.Contains(t, body, `chain_client_gas_used_count{method="RegisterWalletComplianceService"} 1`)
+	require.Contains(t, body, `chain_client_gas_used_sum{method="SendETH"} 21000`)
+	require.Contains(t, body, `chain_client_pending_transactions 0`)
+}
+
+func TestTracing(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		c, _     = NewBlockchainClient(TestEndpoint, WithTimeout(3), WithTracerProvider(provider))
+	)
+	c.Start()
+	defer c.Close()
+
+	resp, err := c.IssueSecurityToken(ctx, data.IssueRequest{
+		PrivateKey:      TestPrivKey,
+		ContractAddress: TestSecurityTokenAddress,
+		Recipient:       TestAccount3,
+		Amount:          "1",
+		IsAsync:         true,
+	})
+	require.NoError(t, err)
+
+	_, err = c.BalanceOfSecurityToken(ctx, data.BalanceOfRequest{ContractAddress: TestSecurityTokenAddress, Account: "invalid"})
+	require.Error(t, err)
+
+	// 非同期トランザクションの結果を待つ
+	time.Sleep(1 * time.Second)
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, s := range recorder.Ended() {
+		spans[s.Name()] = s
+	}
+
+	issue := spans["BlockchainClient.IssueSecurityToken"]
+	require.NotNil(t, issue)
+	attrs := attribute.NewSet(issue.Attributes()...)
+	for k, v := range map[attribute.Key]string{
+		AttrRequestType: data.RequestType_ISSUE.String(),
+		AttrContract:    TestSecurityTokenAddress,
+		AttrTxHash:      resp.Hash,
+		AttrOutcome:     OutcomeSent,
+	} {
+		got, ok := attrs.Value(k)
+		require.True(t, ok, k)
+		require.Equal(t, v, got.AsString())
+	}
+
+	// RPCのspanはメソッドのspanの子になる
+	send := spans["ChainBackend.AsyncSend"]
+	require.NotNil(t, send)
+	require.Equal(t, issue.SpanContext().SpanID(), send.Parent().SpanID())
+
+	// 承認のspanは送信したリクエストのspanにリンクされる
+	confirm := spans["BlockchainClient.confirm"]
+	require.NotNil(t, confirm)
+	require.Len(t, confirm.Links(), 1)
+	require.Equal(t, issue.SpanContext(), confirm.Links()[0].SpanContext)
+
+	balance := spans["BlockchainClient.BalanceOfSecurityToken"]
+	require.NotNil(t, balance)
+	require.Equal(t, codes.Error, balance.Status().Code)
+	attrs = attribute.NewSet(balance.Attributes()...)
+	outcome, _ := attrs.Value(AttrOutcome)
+	require.Equal(t, CategoryValidation, outcome.AsString())
+}
+
+func TestAudit(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		path    = filepath.Join(t.TempDir(), "audit.jsonl")
+		sink, _ = NewFileAuditSink(path)
+		c, _    = NewBlockchainClient(TestEndpoint, WithTimeout(3), WithAuditSink(sink))
+	)
+	c.Start()
+	defer c.Close()
+
+	resp, err := c.IssueSecurityToken(ctx, data.IssueRequest{
+		PrivateKey:      TestPrivKey,
+		ContractAddress: TestSecurityTokenAddress,
+		Recipient:       TestAccount3,
+		Amount:          "1",
+	})
+	require.NoError(t, err)
+
+	_, err = c.GrantRole(ctx, data.GrantRoleRequest{PrivateKey: TestPrivKey, ContractAddress: "invalid"})
+	require.Error(t, err)
+
+	// 参照系は記録されない
+	_, err = c.TotalSupplySecurityToken(ctx, data.TotalSupplyRequest{ContractAddress: TestSecurityTokenAddress})
+	require.NoError(t, err)
+	require.NoError(t, sink.Close())
+
+	count, head, err := VerifyAuditLog(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	require.Equal(t, sink.Head(), head)
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(b), TestPrivKey)
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	var e auditEntry
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &e))
+	require.Equal(t, common.HexToAddress(TestAccount).String(), e.Operator)
+	require.Equal(t, data.RequestType_ISSUE.String(), e.RequestType)
+	require.Equal(t, resp.Hash, e.TxHash)
+	require.Equal(t, OutcomeOK, e.Outcome)
+	require.Equal(t, Redacted, e.Params["private_key"])
+	require.Equal(t, "1", e.Params["amount"])
+
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &e))
+	require.Equal(t, CategoryValidation, e.Outcome)
+
+	// 再度開いた場合はチェーンを継続する
+	sink, err = NewFileAuditSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Record(ctx, AuditRecord{Method: "test"}))
+	require.NoError(t, sink.Close())
+	count, _, err = VerifyAuditLog(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+
+	// 改ざんを検知する
+	tampered := strings.Replace(string(b), `"amount":"1"`, `"amount":"100"`, 1)
+	require.NoError(t, os.WriteFile(path, []byte(tampered), 0600))
+	_, _, err = VerifyAuditLog(path)
+	require.ErrorIs(t, err, ErrAuditTampered)
+
+	// 行の削除を検知する
+	require.NoError(t, os.WriteFile(path, []byte(lines[1]+"\n"), 0600))
+	_, _, err = VerifyAuditLog(path)
+	require.ErrorIs(t, err, ErrAuditTampered)
+}
+
+// ctxAuditSink fails like a remote sink when the context is done
+type ctxAuditSink struct {
+	records []AuditRecord
+}
+
+func (s *ctxAuditSink) Record(ctx context.Context, r AuditRecord) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.records = append(s.records, r)
+	return nil
+}
+
+func TestAuditCanceledCall(t *testing.T) {
+	var (
+		sink = &ctxAuditSink{}
+		c, _ = NewBlockchainClient(TestEndpoint, WithTimeout(3), WithAuditSink(sink))
+	)
+	c.Start()
+	defer c.Close()
+
+	// キャンセルされた呼び出しも記録される
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.IssueSecurityToken(ctx, data.IssueRequest{
+		PrivateKey:      TestPrivKey,
+		ContractAddress: TestSecurityTokenAddress,
+		Recipient:       TestAccount3,
+		Amount:          "1",
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, sink.records, 1)
+	require.Equal(t, CategoryCanceled, sink.records[0].Outcome)
 }
 
 func TestAsyncSend(t *testing.T) {
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	EtherDecimals   = data.EtherDecimals
+	DefaultDecimals = data.DefaultDecimals
+)
+
+// decimalsCache keeps the decimals of the tokens, which never change once deployed.
+type decimalsCache struct {
+	sync.RWMutex
+
+	decimals map[common.Address]uint8
+}
+
+func newDecimalsCache() *decimalsCache {
+	return &decimalsCache{decimals: make(map[common.Address]uint8)}
+}
+
+func (d *decimalsCache) get(token common.Address) (decimals uint8, ok bool) {
+	d.RLock()
+	defer d.RUnlock()
+
+	decimals, ok = d.decimals[token]
+	return
+}
+
+func (d *decimalsCache) set(token common.Address, decimals uint8) {
+	d.Lock()
+	defer d.Unlock()
+
+	d.decimals[token] = decimals
+}
+
+// tokenDecimals resolves the decimals of the token once, then serves them from the cache.
+func (c *BlockchainClient) tokenDecimals(ctx context.Context, token common.Address) (uint8, error) {
+	if decimals, ok := c.decimals.get(token); ok {
+		return decimals, nil
+	}
+
+	var decimals uint8
+	if err := c.query(ctx, c.stABI, token, nil, &decimals, "decimals"); err != nil {
+		return 0, errors.Wrap(err, "failed to query decimals")
+	}
+	c.decimals.set(token, decimals)
+	return decimals, nil
+}
+
+// toBaseUnits converts a human amount of the token into base units.
+func (c *BlockchainClient) toBaseUnits(ctx context.Context, token common.Address, amount string) (*big.Int, error) {
+	decimals, err := c.tokenDecimals(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	wei, err := data.ToWei(amount, int(decimals))
+	if err != nil {
+		// the amount is more precise than the token
+		return nil, invalidRequest(data.NewValidationError(data.NewFieldError("amount", err)))
+	}
+	return wei, nil
+}
@@ -0,0 +1,622 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	eclient "github.com/tak1827/eth-extended-client/client"
+	"github.com/tak1827/go-cache/lru"
+	"github.com/tak1827/transaction-confirmer/confirm"
+)
+
+const (
+	DefaultHealthCheckInterval = int64(5000) // 5 sec
+	DefaultMaxBlockLag         = uint64(3)
+	DefaultMaxLatency          = int64(2000) // 2 sec
+)
+
+var (
+	ErrNoHealthyEndpoint = errors.New("no healthy endpoint")
+)
+
+// Endpoint is a node of a FailoverBackend, the lowest Priority is preferred.
+type Endpoint struct {
+	URL      string `json:"url" yaml:"url"`
+	Priority int    `json:"priority" yaml:"priority"`
+}
+
+// EndpointState is the outcome of the last health check of an endpoint.
+type EndpointState struct {
+	Endpoint
+
+	Healthy     bool
+	Active      bool
+	ChainID     uint64
+	BlockNumber uint64
+	Lag         uint64
+	Latency     time.Duration
+	Err         string
+	CheckedAt   time.Time
+}
+
+type FailoverOption interface {
+	Apply(*FailoverBackend)
+}
+
+type HealthCheckIntervalOpt int64
+
+func (o HealthCheckIntervalOpt) Apply(b *FailoverBackend) {
+	b.interval = time.Duration(o) * time.Millisecond
+}
+func WithHealthCheckInterval(ms int64) HealthCheckIntervalOpt {
+	if ms <= 0 {
+		panic("HealthCheckInterval should be positive")
+	}
+	return HealthCheckIntervalOpt(ms)
+}
+
+type MaxBlockLagOpt uint64
+
+func (o MaxBlockLagOpt) Apply(b *FailoverBackend) {
+	b.maxLag = uint64(o)
+}
+
+// WithMaxBlockLag sets how many blocks an endpoint may be behind the highest one and still be healthy.
+func WithMaxBlockLag(lag uint64) MaxBlockLagOpt {
+	return MaxBlockLagOpt(lag)
+}
+
+type MaxLatencyOpt int64
+
+func (o MaxLatencyOpt) Apply(b *FailoverBackend) {
+	b.maxLatency = time.Duration(o) * time.Millisecond
+}
+func WithMaxLatency(ms int64) MaxLatencyOpt {
+	if ms <= 0 {
+		panic("MaxLatency should be positive")
+	}
+	return MaxLatencyOpt(ms)
+}
+
+type ChainIDOpt uint64
+
+func (o ChainIDOpt) Apply(b *FailoverBackend) {
+	b.chainID = uint64(o)
+}
+
+// WithChainID sets the chain id every endpoint must serve, the chain id of the preferred reachable endpoint by default.
+func WithChainID(id uint64) ChainIDOpt {
+	if id == 0 {
+		panic("ChainID should be positive")
+	}
+	return ChainIDOpt(id)
+}
+
+type FailoverLoggerOpt zerolog.Logger
+
+func (o FailoverLoggerOpt) Apply(b *FailoverBackend) {
+	b.logger = zerolog.Logger(o)
+}
+func WithFailoverLogger(logger zerolog.Logger) FailoverLoggerOpt {
+	return FailoverLoggerOpt(logger)
+}
+
+type member struct {
+	state      EndpointState
+	backend    *EthBackend
+	mismatched bool // serving another chain at the last check
+}
+
+// FailoverBackend spreads the calls over several nodes. Endpoints are health checked periodically
+// and every call goes to the preferred healthy one.
+//
+// Reads failing because of the node are retried on the next healthy endpoint. Writes are not retried,
+// as the transaction may have been broadcast already, but the endpoint is marked unhealthy so that the
+// next calls go elsewhere.
+type FailoverBackend struct {
+	sync.RWMutex
+
+	members    []*member
+	active     *member
+	chainID    uint64
+	interval   time.Duration
+	maxLag     uint64
+	maxLatency time.Duration
+
+	cfmOpts []confirm.Opt
+	ethOpts []eclient.Option
+	logger  zerolog.Logger
+
+	// eth-extended-client sets package level timeouts while dialing
+	dialMu sync.Mutex
+
+	// every member assigns nonces on its own, see syncNonces
+	sendMu  sync.Mutex
+	writer  *EthBackend
+	writers map[common.Address]*EthBackend
+
+	// senders is the member that sent each of the latest transactions, for EnqueueTxHash
+	senders lru.LRUCache
+
+	started bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+var (
+	_ ChainBackend   = (*FailoverBackend)(nil)
+	_ BatchCaller    = (*FailoverBackend)(nil)
+	_ BlockTagReader = (*FailoverBackend)(nil)
+)
+
+// NewFailoverBackend checks the endpoints once, an error is returned when none of them is healthy.
+// Unreachable endpoints are dialed again on every health check.
+func NewFailoverBackend(ctx context.Context, endpoints []Endpoint, cfmOpts []confirm.Opt, ethOpts []eclient.Option, opts ...FailoverOption) (b *FailoverBackend, err error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no endpoint")
+	}
+
+	b = &FailoverBackend{
+		interval:   time.Duration(DefaultHealthCheckInterval) * time.Millisecond,
+		maxLag:     DefaultMaxBlockLag,
+		maxLatency: time.Duration(DefaultMaxLatency) * time.Millisecond,
+		cfmOpts:    cfmOpts,
+		ethOpts:    ethOpts,
+		writers:    make(map[common.Address]*EthBackend),
+		senders:    lru.NewCache(eclient.DefaultConfirmerQueueSize, 0),
+		logger:     DefaultLogger,
+	}
+	for i := range opts {
+		opts[i].Apply(b)
+	}
+
+	for _, e := range endpoints {
+		b.members = append(b.members, &member{state: EndpointState{Endpoint: e}})
+	}
+	sort.SliceStable(b.members, func(i, j int) bool {
+		return b.members[i].state.Priority < b.members[j].state.Priority
+	})
+
+	b.Check(ctx)
+	if b.current() == nil {
+		b.Stop()
+		return nil, b.unhealthy()
+	}
+	return
+}
+
+// unhealthy is the error when no endpoint is healthy, ErrChainIDMismatch when every reachable one serves another chain.
+func (b *FailoverBackend) unhealthy() error {
+	b.RLock()
+	defer b.RUnlock()
+
+	var reachable, mismatched int
+	for _, m := range b.members {
+		if m.state.ChainID != 0 {
+			reachable++
+		}
+		if m.mismatched {
+			mismatched++
+		}
+	}
+	if mismatched > 0 && mismatched == reachable {
+		return errors.Wrapf(ErrChainIDMismatch, "expected=%d on %d endpoints", b.chainID, mismatched)
+	}
+	return errors.Wrapf(ErrNoHealthyEndpoint, "out of %d endpoints", len(b.members))
+}
+
+func (b *FailoverBackend) Start() {
+	b.Lock()
+	b.started = true
+	for _, m := range b.members {
+		if m.backend != nil {
+			m.backend.Start()
+		}
+	}
+	b.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+	b.done = make(chan struct{})
+
+	go func() {
+		defer close(b.done)
+
+		ticker := time.NewTicker(b.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				b.Check(ctx)
+			}
+		}
+	}()
+}
+
+func (b *FailoverBackend) Stop() {
+	if b.cancel != nil {
+		b.cancel()
+		<-b.done
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	for _, m := range b.members {
+		if m.backend != nil {
+			m.backend.Stop()
+		}
+	}
+}
+
+// Endpoints returns the state of the endpoints, by priority.
+func (b *FailoverBackend) Endpoints() []EndpointState {
+	b.RLock()
+	defer b.RUnlock()
+
+	states := make([]EndpointState, len(b.members))
+	for i, m := range b.members {
+		states[i] = m.state
+		states[i].Active = m == b.active
+	}
+	return states
+}
+
+// ChainID is the chain id served by the active endpoint.
+func (b *FailoverBackend) ChainID(ctx context.Context) (*big.Int, error) {
+	b.RLock()
+	defer b.RUnlock()
+
+	if b.active == nil {
+		return nil, ErrNoHealthyEndpoint
+	}
+	return new(big.Int).SetUint64(b.active.state.ChainID), nil
+}
+
+// Check health checks every endpoint and selects the preferred healthy one.
+func (b *FailoverBackend) Check(ctx context.Context) {
+	var wg sync.WaitGroup
+	states := make([]EndpointState, len(b.members))
+	for i, m := range b.members {
+		wg.Add(1)
+		go func(i int, m *member) {
+			defer wg.Done()
+			states[i] = b.check(ctx, m)
+		}(i, m)
+	}
+	wg.Wait()
+
+	b.Lock()
+	defer b.Unlock()
+
+	if b.chainID == 0 {
+		for _, s := range states {
+			if s.Err == "" {
+				b.chainID = s.ChainID
+				break
+			}
+		}
+	}
+
+	var (
+		highest    uint64
+		mismatched = make([]bool, len(states))
+	)
+	for i, s := range states {
+		if s.Err == "" && s.ChainID != b.chainID {
+			mismatched[i] = true
+			states[i].Err = errors.Wrapf(ErrChainIDMismatch, "expected=%d, actual=%d", b.chainID, s.ChainID).Error()
+		}
+		if states[i].Err == "" && s.BlockNumber > highest {
+			highest = s.BlockNumber
+		}
+	}
+
+	previous := b.active
+	b.active = nil
+	for i, m := range b.members {
+		s := states[i]
+		if s.Err == "" {
+			s.Lag = highest - s.BlockNumber
+			s.Healthy = s.Lag <= b.maxLag && s.Latency <= b.maxLatency
+		}
+		m.state, m.mismatched = s, mismatched[i]
+
+		if s.Healthy && b.active == nil {
+			b.active = m
+		}
+	}
+
+	if b.active != previous {
+		b.logSwitch(previous)
+	}
+}
+
+func (b *FailoverBackend) check(ctx context.Context, m *member) (s EndpointState) {
+	ctx, cancel := context.WithTimeout(ctx, b.interval)
+	defer cancel()
+
+	b.RLock()
+	s, backend := m.state, m.backend
+	b.RUnlock()
+
+	s.Healthy, s.CheckedAt, s.Err = false, time.Now(), ""
+
+	if backend == nil {
+		b.dialMu.Lock()
+		var err error
+		backend, err = NewEthBackend(ctx, s.URL, b.cfmOpts, b.ethOpts...)
+		b.dialMu.Unlock()
+		if err != nil {
+			s.Err = err.Error()
+			return
+		}
+
+		b.Lock()
+		m.backend = backend
+		if b.started {
+			backend.Start()
+		}
+		b.Unlock()
+	}
+
+	chainID, err := backend.raw.ChainID(ctx)
+	if err != nil {
+		s.Err = errors.Wrap(err, "failed to get chain id").Error()
+		return
+	}
+	s.ChainID = chainID.Uint64()
+
+	start := time.Now()
+	if s.BlockNumber, err = backend.raw.BlockNumber(ctx); err != nil {
+		s.Err = errors.Wrap(err, "failed to get block number").Error()
+		return
+	}
+	s.Latency = time.Since(start)
+	return
+}
+
+// current must be called without holding the lock.
+func (b *FailoverBackend) current() *member {
+	b.RLock()
+	defer b.RUnlock()
+
+	return b.active
+}
+
+// markDown is called when a call to the endpoint failed because of the node, the next healthy endpoint takes over.
+func (b *FailoverBackend) markDown(m *member, err error) {
+	b.Lock()
+	defer b.Unlock()
+
+	m.state.Healthy = false
+	m.state.Err = err.Error()
+	if b.active != m {
+		return
+	}
+
+	b.active = nil
+	for _, other := range b.members {
+		if other.state.Healthy {
+			b.active = other
+			break
+		}
+	}
+	b.logSwitch(m)
+}
+
+func (b *FailoverBackend) logSwitch(previous *member) {
+	var from, to string
+	if previous != nil {
+		from = previous.state.URL
+	}
+	if b.active != nil {
+		to = b.active.state.URL
+	} else {
+		b.logger.Error().Msgf("no healthy endpoint, previous=%s", from)
+		return
+	}
+	b.logger.Warn().Msgf("switched endpoint, from=%s, to=%s", from, to)
+}
+
+// isNodeError tells if the call failed because of the node, rather than the request or the caller.
+// An error returned by the node itself, as a JSON-RPC error, is not.
+func isNodeError(ctx context.Context, err error) bool {
+	var rpcErr rpc.Error
+	switch {
+	case ctx.Err() != nil:
+		return false
+	case errors.As(err, &rpcErr), errors.Is(err, bind.ErrNoCode), errors.Is(err, ethereum.NotFound):
+		return false
+	default:
+		return true
+	}
+}
+
+// read calls fn on the active endpoint, then on the other healthy endpoints as long as the node is to blame.
+func (b *FailoverBackend) read(ctx context.Context, fn func(*EthBackend) error) (err error) {
+	tried := make(map[*member]bool)
+	for {
+		m := b.current()
+		if m == nil || tried[m] {
+			m = b.next(tried)
+		}
+		if m == nil {
+			if len(tried) == 0 {
+				err = ErrNoHealthyEndpoint
+			} else {
+				err = errors.Wrapf(err, "failed on %d endpoints", len(tried))
+			}
+			return
+		}
+
+		if err = fn(m.backend); err == nil || !isNodeError(ctx, err) {
+			return
+		}
+		tried[m] = true
+		b.markDown(m, err)
+	}
+}
+
+func (b *FailoverBackend) next(tried map[*member]bool) *member {
+	b.RLock()
+	defer b.RUnlock()
+
+	for _, m := range b.members {
+		if m.state.Healthy && !tried[m] {
+			return m
+		}
+	}
+	return nil
+}
+
+func (b *FailoverBackend) write(ctx context.Context, fn func(*EthBackend) error) (err error) {
+	m := b.current()
+	if m == nil {
+		return ErrNoHealthyEndpoint
+	}
+
+	if err = fn(m.backend); err != nil && isNodeError(ctx, err) {
+		b.markDown(m, err)
+	}
+	return
+}
+
+// send is write for the transactions, the nonces being synced first when the endpoint changed.
+func (b *FailoverBackend) send(ctx context.Context, priv string, fn func(*EthBackend) error) error {
+	return b.write(ctx, func(e *EthBackend) error {
+		if err := b.syncNonces(ctx, e, priv); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// syncNonces prepares the active endpoint to send after another one did.
+// Its eth client forgets the nonces it cached, as they may have been used through the other endpoint meanwhile,
+// and the pending transactions of the account must be mined, as the nonce is read from the latest block.
+func (b *FailoverBackend) syncNonces(ctx context.Context, e *EthBackend, priv string) error {
+	key, err := crypto.HexToECDSA(priv)
+	if err != nil {
+		return errors.Wrap(err, "invalid private key")
+	}
+	account := crypto.PubkeyToAddress(key.PublicKey)
+
+	b.sendMu.Lock()
+	defer b.sendMu.Unlock()
+
+	if b.writer != nil && b.writer != e {
+		b.logger.Info().Msgf("resetting nonces, from=%s, to=%s", b.writer.endpoint, e.endpoint)
+
+		e.resetNonces()
+	}
+	b.writer = e
+
+	if previous, ok := b.writers[account]; ok && previous != e {
+		if err = e.waitPending(ctx, account); err != nil {
+			return err
+		}
+	}
+	b.writers[account] = e
+	return nil
+}
+
+func (b *FailoverBackend) SyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (hash string, err error) {
+	err = b.send(ctx, priv, func(e *EthBackend) (err error) {
+		hash, err = e.SyncSend(ctx, priv, to, amount, input, gasLimit)
+		return
+	})
+	return
+}
+
+func (b *FailoverBackend) AsyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (hash string, err error) {
+	err = b.send(ctx, priv, func(e *EthBackend) (err error) {
+		if hash, err = e.AsyncSend(ctx, priv, to, amount, input, gasLimit); err == nil {
+			b.senders.Add(hash, e)
+		}
+		return
+	})
+	return
+}
+
+// EnqueueTxHash enqueues the hash on the member that sent the transaction, which may not be the active one anymore.
+func (b *FailoverBackend) EnqueueTxHash(ctx context.Context, hash string) error {
+	if sender, ok := b.senders.Get(hash); ok {
+		b.senders.Remove(hash)
+		return sender.(*EthBackend).EnqueueTxHash(ctx, hash)
+	}
+
+	return b.write(ctx, func(e *EthBackend) error {
+		return e.EnqueueTxHash(ctx, hash)
+	})
+}
+
+func (b *FailoverBackend) Receipt(ctx context.Context, hash string) (receipt *types.Receipt, err error) {
+	err = b.read(ctx, func(e *EthBackend) (err error) {
+		receipt, err = e.Receipt(ctx, hash)
+		return
+	})
+	return
+}
+
+func (b *FailoverBackend) CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) (output []byte, err error) {
+	err = b.read(ctx, func(e *EthBackend) (err error) {
+		output, err = e.CallContract(ctx, to, input, block)
+		return
+	})
+	return
+}
+
+func (b *FailoverBackend) BatchCallContract(ctx context.Context, calls []Call, block *big.Int) (results []CallResult, err error) {
+	err = b.read(ctx, func(e *EthBackend) (err error) {
+		results, err = e.BatchCallContract(ctx, calls, block)
+		return
+	})
+	return
+}
+
+func (b *FailoverBackend) HeaderByTag(ctx context.Context, tag string) (head *types.Header, err error) {
+	err = b.read(ctx, func(e *EthBackend) (err error) {
+		head, err = e.HeaderByTag(ctx, tag)
+		return
+	})
+	return
+}
+
+func (b *FailoverBackend) BalanceAt(ctx context.Context, account common.Address, block *big.Int) (balance *big.Int, err error) {
+	err = b.read(ctx, func(e *EthBackend) (err error) {
+		balance, err = e.BalanceAt(ctx, account, block)
+		return
+	})
+	return
+}
+
+func (b *FailoverBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	err = b.read(ctx, func(e *EthBackend) (err error) {
+		logs, err = e.FilterLogs(ctx, query)
+		return
+	})
+	return
+}
+
+func (b *FailoverBackend) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	err = b.read(ctx, func(e *EthBackend) (err error) {
+		header, err = e.HeaderByNumber(ctx, number)
+		return
+	})
+	return
+}
@@ -0,0 +1,288 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	DeadEndpoint = "http://127.0.0.1:1"
+)
+
+// stubNode answers the health checks and eth_getBalance.
+type stubNode struct {
+	chainID  uint64
+	block    uint64
+	balances int32
+}
+
+func (n *stubNode) ChainId() hexutil.Uint64 {
+	return hexutil.Uint64(atomic.LoadUint64(&n.chainID))
+}
+
+func (n *stubNode) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(atomic.LoadUint64(&n.block))
+}
+
+func (n *stubNode) GetBalance(account common.Address, number rpc.BlockNumber) *hexutil.Big {
+	atomic.AddInt32(&n.balances, 1)
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func newStubNode(t *testing.T, chainID, block uint64) (*stubNode, *httptest.Server) {
+	node := &stubNode{chainID: chainID, block: block}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", node))
+
+	srv := httptest.NewServer(server)
+	t.Cleanup(srv.Close)
+	return node, srv
+}
+
+func TestFailoverBackend(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		primary, primarySrv  = newStubNode(t, 1337, 100)
+		secondary, secondSrv = newStubNode(t, 1337, 100)
+		_, wrongSrv          = newStubNode(t, 5, 100)
+	)
+
+	b, err := NewFailoverBackend(ctx, []Endpoint{
+		{URL: wrongSrv.URL, Priority: 0},
+		{URL: secondSrv.URL, Priority: 2},
+		{URL: primarySrv.URL, Priority: 1},
+		{URL: DeadEndpoint, Priority: 3},
+	}, nil, nil, WithChainID(1337), WithHealthCheckInterval(60*1000))
+	require.NoError(t, err)
+	b.Start()
+	defer b.Stop()
+
+	// 優先度順に並び、チェーンIDが異なるノードと接続できないノードは除外される
+	states := b.Endpoints()
+	require.Equal(t, []string{wrongSrv.URL, primarySrv.URL, secondSrv.URL, DeadEndpoint}, []string{states[0].URL, states[1].URL, states[2].URL, states[3].URL})
+	require.False(t, states[0].Healthy)
+	require.Contains(t, states[0].Err, "chain id mismatch")
+	require.True(t, states[1].Healthy)
+	require.True(t, states[1].Active)
+	require.False(t, states[3].Healthy)
+	require.NotEmpty(t, states[3].Err)
+
+	// ブロックが遅れているノードから切り替わる
+	atomic.StoreUint64(&primary.block, 90)
+	b.Check(ctx)
+	states = b.Endpoints()
+	require.False(t, states[1].Healthy)
+	require.Equal(t, uint64(10), states[1].Lag)
+	require.True(t, states[2].Active)
+
+	// 追いつけば戻る
+	atomic.StoreUint64(&primary.block, 100)
+	b.Check(ctx)
+	require.True(t, b.Endpoints()[1].Active)
+
+	_, err = b.BalanceAt(ctx, common.HexToAddress(TestAccount), nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&primary.balances))
+
+	// ノードが落ちた場合は次のノードで読み込む
+	primarySrv.Close()
+	_, err = b.BalanceAt(ctx, common.HexToAddress(TestAccount), nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&secondary.balances))
+	require.True(t, b.Endpoints()[2].Active)
+
+	// 全て落ちた場合
+	secondSrv.Close()
+	_, err = b.BalanceAt(ctx, common.HexToAddress(TestAccount), nil)
+	require.Error(t, err)
+	_, err = b.BalanceAt(ctx, common.HexToAddress(TestAccount), nil)
+	require.ErrorIs(t, err, ErrNoHealthyEndpoint)
+}
+
+func TestWithEndpoints(t *testing.T) {
+	var ctx = context.Background()
+
+	_, err := NewBlockchainClient("", WithTimeout(3), WithEndpoints([]Endpoint{{URL: DeadEndpoint}}))
+	require.ErrorIs(t, err, ErrNoHealthyEndpoint)
+
+	c, err := NewBlockchainClient("", WithTimeout(3), WithEndpoints([]Endpoint{
+		{URL: DeadEndpoint, Priority: 0},
+		{URL: TestEndpoint, Priority: 1},
+	}))
+	require.NoError(t, err)
+	c.Start()
+	defer c.Close()
+
+	_, err = c.BalanceOfETH(ctx, data.BalanceOfETHRequest{Account: TestAccount})
+	require.NoError(t, err)
+
+	states := c.Endpoints()
+	require.Len(t, states, 2)
+	require.False(t, states[0].Healthy)
+	require.True(t, states[1].Active)
+	require.Equal(t, uint64(1337), states[1].ChainID)
+
+	// 遅延接続でもエンドポイントの状態を返す
+	lazy, err := NewBlockchainClient("", WithTimeout(3), WithLazyConnect(WithReconnectBackoff(10, 40)), WithEndpoints([]Endpoint{
+		{URL: DeadEndpoint, Priority: 0},
+		{URL: TestEndpoint, Priority: 1},
+	}))
+	require.NoError(t, err)
+	require.Nil(t, lazy.Endpoints())
+	lazy.Start()
+	defer lazy.Close()
+
+	require.Eventually(t, lazy.Ready, 5*time.Second, 10*time.Millisecond)
+	states = lazy.Endpoints()
+	require.Len(t, states, 2)
+	require.True(t, states[1].Active)
+}
+
+// rejectingNode proxies the simulated chain, failing the transactions while rejecting
+type rejectingNode struct {
+	rejecting int32
+	sent      int32
+	receipts  int32
+	proxy     *httputil.ReverseProxy
+}
+
+func (n *rejectingNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if bytes.Contains(body, []byte("eth_sendRawTransaction")) {
+		if atomic.LoadInt32(&n.rejecting) == 1 {
+			http.Error(w, "node restarting", http.StatusServiceUnavailable)
+			return
+		}
+		atomic.AddInt32(&n.sent, 1)
+	}
+	if bytes.Contains(body, []byte("eth_getTransactionReceipt")) {
+		atomic.AddInt32(&n.receipts, 1)
+	}
+	n.proxy.ServeHTTP(w, r)
+}
+
+func TestFailoverNonces(t *testing.T) {
+	target, err := url.Parse(TestEndpoint)
+	require.NoError(t, err)
+
+	var (
+		ctx       = context.Background()
+		primary   = &rejectingNode{proxy: httputil.NewSingleHostReverseProxy(target)}
+		secondary = &rejectingNode{proxy: httputil.NewSingleHostReverseProxy(target)}
+		req       = data.SendETHRequest{PrivateKey: TestPrivKey2, Recipient: TestAccount4, Amount: "0.001"}
+	)
+	primarySrv, secondSrv := httptest.NewServer(primary), httptest.NewServer(secondary)
+	defer primarySrv.Close()
+	defer secondSrv.Close()
+
+	c, err := NewBlockchainClient("", WithTimeout(3), WithEndpoints([]Endpoint{
+		{URL: primarySrv.URL, Priority: 0},
+		{URL: secondSrv.URL, Priority: 1},
+	}, WithHealthCheckInterval(60*1000)))
+	require.NoError(t, err)
+	c.Start()
+	defer c.Close()
+	backend := c.backend.(*FailoverBackend)
+
+	_, err = c.SendETH(ctx, req)
+	require.NoError(t, err)
+
+	// 送信に失敗したノードから切り替わる
+	atomic.StoreInt32(&primary.rejecting, 1)
+	_, err = c.SendETH(ctx, req)
+	require.Error(t, err)
+	require.True(t, backend.Endpoints()[1].Active)
+
+	_, err = c.SendETH(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&secondary.sent))
+
+	// 戻った後も使用済みのノンスを再利用しない
+	atomic.StoreInt32(&primary.rejecting, 0)
+	backend.Check(ctx)
+	require.True(t, backend.Endpoints()[0].Active)
+
+	_, err = c.SendETH(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&primary.sent))
+}
+
+func TestFailoverEnqueueTxHash(t *testing.T) {
+	target, err := url.Parse(TestEndpoint)
+	require.NoError(t, err)
+
+	var (
+		ctx       = context.Background()
+		primary   = &rejectingNode{proxy: httputil.NewSingleHostReverseProxy(target)}
+		secondary = &rejectingNode{proxy: httputil.NewSingleHostReverseProxy(target)}
+		recipient = common.HexToAddress(TestAccount4)
+	)
+	primarySrv, secondSrv := httptest.NewServer(primary), httptest.NewServer(secondary)
+	defer primarySrv.Close()
+	defer secondSrv.Close()
+
+	c, err := NewBlockchainClient("", WithTimeout(3), WithEndpoints([]Endpoint{
+		{URL: primarySrv.URL, Priority: 0},
+		{URL: secondSrv.URL, Priority: 1},
+	}, WithHealthCheckInterval(60*1000)))
+	require.NoError(t, err)
+	c.Start()
+	defer c.Close()
+	backend := c.backend.(*FailoverBackend)
+
+	hash, err := backend.AsyncSend(ctx, TestPrivKey2, &recipient, big.NewInt(1), nil, 0)
+	require.NoError(t, err)
+
+	// 送信後に切り替わっても、送信したノードで確認する
+	atomic.StoreInt32(&primary.rejecting, 1)
+	_, err = backend.AsyncSend(ctx, TestPrivKey2, &recipient, big.NewInt(1), nil, 0)
+	require.Error(t, err)
+	require.True(t, backend.Endpoints()[1].Active)
+
+	require.NoError(t, backend.EnqueueTxHash(ctx, hash))
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&primary.receipts) > 0 }, 5*time.Second, 10*time.Millisecond)
+	require.Zero(t, atomic.LoadInt32(&secondary.receipts))
+}
+
+func TestEthBackendConnection(t *testing.T) {
+	var ctx = context.Background()
+
+	b, err := NewEthBackend(ctx, TestEndpoint, nil)
+	require.NoError(t, err)
+	defer b.Stop()
+
+	// eth clientの接続で直接呼び出せる
+	chainID, err := b.ChainID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1337), chainID.Uint64())
+
+	head, err := b.HeaderByTag(ctx, "latest")
+	require.NoError(t, err)
+	require.NotNil(t, head.Number)
+
+	// 依存先の構造が変わった場合は気付けるようにする
+	require.Panics(t, func() { fieldPointer(b.client, "ethclient", reflect.TypeOf(b.rpc)) })
+	require.Panics(t, func() { fieldPointer(b.client, "missing", reflect.TypeOf(b.rpc)) })
+}
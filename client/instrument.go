@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+	eclient "github.com/tak1827/eth-extended-client/client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	CategoryValidation = "validation"
+	CategoryTimeout    = "timeout"
+	CategoryCanceled   = "canceled"
+	CategoryReverted   = "reverted"
+	CategoryNoContract = "no_contract"
+	CategoryNoEvent    = "no_event"
+	CategoryBadOutput  = "bad_output"
+	CategoryPanic      = "panic"
+	CategoryNotReady   = "not_ready"
+	CategoryRejected   = "rejected"
+	CategoryPending    = "pending"
+	CategoryRPC        = "rpc"
+)
+
+// ErrPanic is returned by the methods recovering from a panic, so that a single bad response can't crash the process.
+var ErrPanic = errors.New("panic recovered")
+
+// InvalidRequestError is returned when the request is rejected before anything is sent to the node.
+type InvalidRequestError struct {
+	Err error
+}
+
+func (e *InvalidRequestError) Error() string {
+	return "at Validate: " + e.Err.Error()
+}
+
+func (e *InvalidRequestError) Unwrap() error {
+	return e.Err
+}
+
+func invalidRequest(err error) error {
+	return &InvalidRequestError{Err: err}
+}
+
+// ErrorCategory classifies the errors returned by BlockchainClient methods.
+func ErrorCategory(err error) string {
+	var invalid *InvalidRequestError
+	switch {
+	case errors.As(err, &invalid):
+		return CategoryValidation
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, eclient.ErrSyncSendTimeout):
+		return CategoryTimeout
+	case errors.Is(err, context.Canceled):
+		return CategoryCanceled
+	case errors.Is(err, ErrTxReverted):
+		return CategoryReverted
+	case errors.Is(err, bind.ErrNoCode):
+		return CategoryNoContract
+	case errors.Is(err, ErrCreatedEventNotFound):
+		return CategoryNoEvent
+	case errors.Is(err, ErrUnexpectedOutput):
+		return CategoryBadOutput
+	case errors.Is(err, ErrPanic):
+		return CategoryPanic
+	case errors.Is(err, ErrNotReady):
+		return CategoryNotReady
+	case errors.Is(err, ErrIssuanceRejected):
+		return CategoryRejected
+	case errors.Is(err, ErrIssuePending):
+		return CategoryPending
+	default:
+		return CategoryRPC
+	}
+}
+
+// call records what is observed about a single BlockchainClient method call.
+// Methods fill the fields they know about while running, end reports them.
+type call struct {
+	ctx     context.Context
+	method  string
+	typ     data.RequestType
+	req     interface{}
+	start   time.Time
+	hash    string
+	async   bool
+	gasUsed uint64
+	span    trace.Span
+	cancel  context.CancelFunc
+}
+
+// withTimeout bounds ctx by timeout, unless the caller set a deadline already.
+func (c *BlockchainClient) withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// timeoutOf is the default deadline of the request, sync sends also waiting for the confirmation.
+func (c *BlockchainClient) timeoutOf(method string, req interface{}) time.Duration {
+	if t, ok := c.timeouts.methods[method]; ok {
+		return t
+	}
+	if _, ok := req.(interface{ GetPrivateKey() string }); !ok {
+		return c.timeouts.rpc
+	}
+	if r, ok := req.(interface{ GetIsAsync() bool }); ok && r.GetIsAsync() {
+		return c.timeouts.rpc
+	}
+	return c.timeouts.rpc + c.timeouts.confirm
+}
+
+// begin starts the span of the method when tracing is enabled, the returned context carries it down to the backend.
+// The context is bounded by the client timeouts when the caller set no deadline.
+func (c *BlockchainClient) begin(ctx context.Context, method string, typ data.RequestType, req interface{}) (context.Context, *call) {
+	cl := &call{method: method, typ: typ, req: req, start: time.Now()}
+	ctx, cl.cancel = c.withTimeout(ctx, c.timeoutOf(method, req))
+
+	if c.tracer != nil {
+		attrs := []attribute.KeyValue{AttrRequestType.String(typ.String())}
+		if r, ok := req.(interface{ GetContractAddress() string }); ok && r.GetContractAddress() != "" {
+			attrs = append(attrs, AttrContract.String(r.GetContractAddress()))
+		}
+		ctx, cl.span = c.tracer.Start(ctx, "BlockchainClient."+method, trace.WithAttributes(attrs...))
+	}
+
+	cl.ctx = ctx
+	return ctx, cl
+}
+
+// end is meant to be deferred with a pointer to the named error result.
+// A panic of the method is recovered and returned as ErrPanic.
+func (c *BlockchainClient) end(cl *call, err *error) {
+	if r := recover(); r != nil {
+		*err = c.recovered(cl.method, r)
+	}
+	defer cl.cancel()
+
+	if cl.span != nil {
+		defer func() {
+			cl.span.SetAttributes(AttrTxHash.String(cl.hash), AttrAsync.Bool(cl.async))
+			outcome := OutcomeOK
+			if cl.async {
+				outcome = OutcomeSent
+			}
+			endSpan(cl.span, outcome, *err)
+		}()
+	}
+
+	c.metrics.observeCall(cl.method, time.Since(cl.start), *err)
+	if c.auditSink != nil {
+		c.audit(cl, *err)
+	}
+
+	// async sends are observed by the tracker once mined
+	if *err != nil || cl.gasUsed == 0 || cl.async {
+		return
+	}
+	c.metrics.observeGas(cl.method, cl.gasUsed)
+}
+
+// recovered logs the stack of a recovered panic, and turns it into an error.
+func (c *BlockchainClient) recovered(method string, r interface{}) error {
+	c.logger.Error().Msgf("recovered from panic in %s: %v\n%s", method, r, debug.Stack())
+	return errors.Wrapf(ErrPanic, "in %s: %v", method, r)
+}
@@ -0,0 +1,402 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	DefaultReconnectMinBackoff    = int64(500)   // 0.5 sec
+	DefaultReconnectMaxBackoff    = int64(30000) // 30 sec
+	DefaultReconnectCheckInterval = int64(5000)  // 5 sec
+	DefaultReconnectMaxFailures   = 3
+)
+
+var (
+	ErrNotReady = errors.New("not connected to the node yet")
+)
+
+// Health is the state of the connection of a client to its node.
+type Health struct {
+	Ready bool
+
+	// Attempts counts the failed dials since the last connection
+	Attempts    int
+	Err         string
+	ConnectedAt time.Time
+	CheckedAt   time.Time
+}
+
+type ReconnectOption interface {
+	Apply(*LazyBackend)
+}
+
+type ReconnectBackoffOpt struct {
+	min, max time.Duration
+}
+
+func (o ReconnectBackoffOpt) Apply(b *LazyBackend) {
+	b.minBackoff, b.maxBackoff = o.min, o.max
+}
+
+// WithReconnectBackoff sets the wait after the first failed dial, doubled on every failure up to max, in ms.
+func WithReconnectBackoff(min, max int64) ReconnectBackoffOpt {
+	if min <= 0 || max < min {
+		panic("ReconnectBackoff should be positive, with max not less than min")
+	}
+	return ReconnectBackoffOpt{min: time.Duration(min) * time.Millisecond, max: time.Duration(max) * time.Millisecond}
+}
+
+type ReconnectCheckIntervalOpt int64
+
+func (o ReconnectCheckIntervalOpt) Apply(b *LazyBackend) {
+	b.interval = time.Duration(o) * time.Millisecond
+}
+
+// WithReconnectCheckInterval sets how often the connection is checked once connected.
+func WithReconnectCheckInterval(ms int64) ReconnectCheckIntervalOpt {
+	if ms <= 0 {
+		panic("ReconnectCheckInterval should be positive")
+	}
+	return ReconnectCheckIntervalOpt(ms)
+}
+
+type ReconnectMaxFailuresOpt int
+
+func (o ReconnectMaxFailuresOpt) Apply(b *LazyBackend) {
+	b.maxFailures = int(o)
+}
+
+// WithReconnectMaxFailures sets how many checks in a row must fail before the connection is dropped and dialed again.
+func WithReconnectMaxFailures(n int) ReconnectMaxFailuresOpt {
+	if n <= 0 {
+		panic("ReconnectMaxFailures should be positive")
+	}
+	return ReconnectMaxFailuresOpt(n)
+}
+
+type ReconnectLoggerOpt zerolog.Logger
+
+func (o ReconnectLoggerOpt) Apply(b *LazyBackend) {
+	b.logger = zerolog.Logger(o)
+}
+func WithReconnectLogger(logger zerolog.Logger) ReconnectLoggerOpt {
+	return ReconnectLoggerOpt(logger)
+}
+
+// lazyConn is a connection in use by the calls holding it, stopped once dropped and released by all of them.
+type lazyConn struct {
+	ChainBackend
+
+	users sync.WaitGroup
+}
+
+// dialFunc connects to the node, the returned backend is not started yet.
+type dialFunc func(ctx context.Context) (ChainBackend, error)
+
+// LazyBackend connects to the node in background once started, retrying with exponential backoff.
+// The connection is checked periodically, and dialed again after too many failed checks.
+// Calls made while not connected fail with ErrNotReady, a dropped connection is stopped once the calls using it are done.
+//
+// The transactions sent asynchronously before a reconnection are not confirmed by the new connection.
+type LazyBackend struct {
+	sync.RWMutex
+
+	dial    dialFunc
+	backend *lazyConn
+	health  Health
+
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	interval    time.Duration
+	maxFailures int
+	timeout     time.Duration
+	failures    int
+	logger      zerolog.Logger
+
+	cancel   context.CancelFunc
+	done     chan struct{}
+	dropping sync.WaitGroup // the dropped connections not stopped yet
+}
+
+var (
+	_ ChainBackend   = (*LazyBackend)(nil)
+	_ BatchCaller    = (*LazyBackend)(nil)
+	_ BlockTagReader = (*LazyBackend)(nil)
+)
+
+// NewLazyBackend does not dial, dial is called once started with timeout as deadline.
+func NewLazyBackend(dial dialFunc, timeout time.Duration, opts ...ReconnectOption) *LazyBackend {
+	b := &LazyBackend{
+		dial:        dial,
+		minBackoff:  time.Duration(DefaultReconnectMinBackoff) * time.Millisecond,
+		maxBackoff:  time.Duration(DefaultReconnectMaxBackoff) * time.Millisecond,
+		interval:    time.Duration(DefaultReconnectCheckInterval) * time.Millisecond,
+		maxFailures: DefaultReconnectMaxFailures,
+		timeout:     timeout,
+		logger:      DefaultLogger,
+	}
+	for i := range opts {
+		opts[i].Apply(b)
+	}
+	return b
+}
+
+func (b *LazyBackend) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+	b.done = make(chan struct{})
+
+	go func() {
+		defer close(b.done)
+		b.run(ctx)
+	}()
+}
+
+func (b *LazyBackend) Stop() {
+	if b.cancel != nil {
+		b.cancel()
+		<-b.done
+	}
+
+	b.Lock()
+	b.health.Ready, b.health.Err = false, "stopped"
+	if b.backend != nil {
+		b.drop(b.backend)
+		b.backend = nil
+	}
+	b.Unlock()
+
+	b.dropping.Wait()
+}
+
+// drop stops the connection once the calls holding it are done, it must be called holding the lock.
+func (b *LazyBackend) drop(conn *lazyConn) {
+	b.dropping.Add(1)
+	go func() {
+		defer b.dropping.Done()
+		conn.users.Wait()
+		conn.Stop()
+	}()
+}
+
+// Health returns the state of the connection.
+func (b *LazyBackend) Health() Health {
+	b.RLock()
+	defer b.RUnlock()
+
+	return b.health
+}
+
+func (b *LazyBackend) run(ctx context.Context) {
+	backoff := b.minBackoff
+	for {
+		wait := b.interval
+		if b.current() == nil {
+			if err := b.connect(ctx); err != nil {
+				wait = backoff
+				if backoff *= 2; backoff > b.maxBackoff {
+					backoff = b.maxBackoff
+				}
+			} else {
+				backoff = b.minBackoff
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		if b.current() != nil {
+			b.check(ctx)
+		}
+	}
+}
+
+func (b *LazyBackend) connect(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, b.timeout)
+	defer cancel()
+
+	backend, err := b.dial(ctx)
+
+	b.Lock()
+	defer b.Unlock()
+
+	b.health.CheckedAt = time.Now()
+	if err != nil {
+		b.health.Attempts++
+		b.health.Err = err.Error()
+		b.logger.Warn().Err(err).Msgf("failed to connect, attempts=%d", b.health.Attempts)
+		return err
+	}
+
+	backend.Start()
+	b.backend, b.failures = &lazyConn{ChainBackend: backend}, 0
+	b.health = Health{Ready: true, ConnectedAt: b.health.CheckedAt, CheckedAt: b.health.CheckedAt}
+	b.logger.Info().Msg("connected")
+	return nil
+}
+
+// check drops the connection after maxFailures failed checks in a row.
+func (b *LazyBackend) check(ctx context.Context) {
+	backend := b.current()
+
+	cctx, cancel := context.WithTimeout(ctx, b.timeout)
+	_, err := backend.HeaderByNumber(cctx, nil)
+	cancel()
+	if ctx.Err() != nil {
+		return
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	b.health.CheckedAt = time.Now()
+	if err == nil {
+		b.failures, b.health.Err = 0, ""
+		return
+	}
+
+	b.failures++
+	b.health.Err = err.Error()
+	if b.failures < b.maxFailures || b.backend != backend {
+		return
+	}
+
+	b.logger.Error().Err(err).Msgf("connection lost after %d failed checks, reconnecting", b.failures)
+	b.backend = nil
+	b.health.Ready = false
+	b.drop(backend)
+}
+
+func (b *LazyBackend) current() *lazyConn {
+	b.RLock()
+	defer b.RUnlock()
+
+	return b.backend
+}
+
+// connected holds the connection until release is called, so that it is not stopped by a reconnection meanwhile.
+func (b *LazyBackend) connected() (backend ChainBackend, release func(), err error) {
+	b.RLock()
+	defer b.RUnlock()
+
+	if b.backend == nil {
+		return nil, nil, ErrNotReady
+	}
+	b.backend.users.Add(1)
+	return b.backend.ChainBackend, b.backend.users.Done, nil
+}
+
+func (b *LazyBackend) SyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (string, error) {
+	backend, release, err := b.connected()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return backend.SyncSend(ctx, priv, to, amount, input, gasLimit)
+}
+
+func (b *LazyBackend) AsyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (string, error) {
+	backend, release, err := b.connected()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return backend.AsyncSend(ctx, priv, to, amount, input, gasLimit)
+}
+
+func (b *LazyBackend) EnqueueTxHash(ctx context.Context, hash string) error {
+	backend, release, err := b.connected()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return backend.EnqueueTxHash(ctx, hash)
+}
+
+func (b *LazyBackend) Receipt(ctx context.Context, hash string) (*types.Receipt, error) {
+	backend, release, err := b.connected()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return backend.Receipt(ctx, hash)
+}
+
+func (b *LazyBackend) CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) ([]byte, error) {
+	backend, release, err := b.connected()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return backend.CallContract(ctx, to, input, block)
+}
+
+func (b *LazyBackend) BatchCallContract(ctx context.Context, calls []Call, block *big.Int) ([]CallResult, error) {
+	backend, release, err := b.connected()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return batchCallContract(ctx, backend, calls, block)
+}
+
+func (b *LazyBackend) BalanceAt(ctx context.Context, account common.Address, block *big.Int) (*big.Int, error) {
+	backend, release, err := b.connected()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return backend.BalanceAt(ctx, account, block)
+}
+
+func (b *LazyBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	backend, release, err := b.connected()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return backend.FilterLogs(ctx, query)
+}
+
+func (b *LazyBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	backend, release, err := b.connected()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return backend.HeaderByNumber(ctx, number)
+}
+
+func (b *LazyBackend) HeaderByTag(ctx context.Context, tag string) (*types.Header, error) {
+	backend, release, err := b.connected()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return headerByTag(ctx, backend, tag)
+}
+
+func (b *LazyBackend) ChainID(ctx context.Context) (*big.Int, error) {
+	backend, release, err := b.connected()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	r, ok := backend.(chainIDReader)
+	if !ok {
+		return nil, errors.New("backend can not tell its chain id")
+	}
+	return r.ChainID(ctx)
+}
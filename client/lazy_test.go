@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyNode proxies the simulated chain, answering 503 while down
+type flakyNode struct {
+	down  int32
+	proxy *httputil.ReverseProxy
+}
+
+func (n *flakyNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if atomic.LoadInt32(&n.down) == 1 {
+		http.Error(w, "node restarting", http.StatusServiceUnavailable)
+		return
+	}
+	n.proxy.ServeHTTP(w, r)
+}
+
+func (n *flakyNode) setDown(down bool) {
+	var v int32
+	if down {
+		v = 1
+	}
+	atomic.StoreInt32(&n.down, v)
+}
+
+func TestLazyConnect(t *testing.T) {
+	target, err := url.Parse(TestEndpoint)
+	require.NoError(t, err)
+
+	var (
+		ctx  = context.Background()
+		node = &flakyNode{down: 1, proxy: httputil.NewSingleHostReverseProxy(target)}
+		srv  = httptest.NewServer(node)
+		req  = data.NameRequest{ContractAddress: TestSecurityTokenAddress}
+	)
+	defer srv.Close()
+
+	// ノードが落ちていても生成できる
+	_, err = NewBlockchainClient(srv.URL, WithTimeout(3), WithDialTimeout(1))
+	require.Error(t, err)
+
+	c, err := NewBlockchainClient(srv.URL, WithTimeout(3), WithDialTimeout(1), WithLazyConnect(WithReconnectBackoff(10, 40), WithReconnectCheckInterval(20), WithReconnectMaxFailures(2)))
+	require.NoError(t, err)
+	require.False(t, c.Ready())
+	c.Start()
+	defer c.Close()
+
+	_, err = c.NameSecurityToken(ctx, req)
+	require.ErrorIs(t, err, ErrNotReady)
+	require.Equal(t, CategoryNotReady, ErrorCategory(err))
+
+	// 接続を再試行している
+	require.Eventually(t, func() bool { return c.Health().Attempts >= 2 }, time.Second, 10*time.Millisecond)
+	require.NotEmpty(t, c.Health().Err)
+
+	node.setDown(false)
+	require.Eventually(t, c.Ready, 2*time.Second, 10*time.Millisecond)
+	h := c.Health()
+	require.Zero(t, h.Attempts)
+	require.False(t, h.ConnectedAt.IsZero())
+
+	res, err := c.NameSecurityToken(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, "Test Token Name", res.GetName())
+
+	// 接続が切れると再接続する
+	node.setDown(true)
+	require.Eventually(t, func() bool { return !c.Ready() }, 2*time.Second, 10*time.Millisecond)
+
+	node.setDown(false)
+	require.Eventually(t, c.Ready, 2*time.Second, 10*time.Millisecond)
+	require.True(t, c.Health().ConnectedAt.After(h.ConnectedAt))
+
+	res, err = c.NameSecurityToken(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, "Test Token Name", res.GetName())
+}
+
+// holdingBackend blocks the calls until released
+type holdingBackend struct {
+	ChainBackend
+
+	entered chan struct{}
+	release chan struct{}
+	stopped int32
+}
+
+func (b *holdingBackend) Start() {}
+func (b *holdingBackend) Stop()  { atomic.StoreInt32(&b.stopped, 1) }
+func (b *holdingBackend) CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) ([]byte, error) {
+	close(b.entered)
+	<-b.release
+	return nil, nil
+}
+
+func TestLazyStop(t *testing.T) {
+	var (
+		backend = &holdingBackend{entered: make(chan struct{}), release: make(chan struct{})}
+		b       = NewLazyBackend(func(ctx context.Context) (ChainBackend, error) { return backend, nil }, time.Second)
+		called  = make(chan error)
+		stopped = make(chan struct{})
+	)
+	b.Start()
+	require.Eventually(t, func() bool { return b.Health().Ready }, time.Second, 10*time.Millisecond)
+
+	go func() {
+		_, err := b.CallContract(context.Background(), common.Address{}, nil, nil)
+		called <- err
+	}()
+	<-backend.entered
+
+	go func() {
+		b.Stop()
+		close(stopped)
+	}()
+
+	// 実行中の呼び出しが終わるまで止めない
+	require.Eventually(t, func() bool { return !b.Health().Ready }, time.Second, 10*time.Millisecond)
+	require.Equal(t, "stopped", b.Health().Err)
+	_, err := b.CallContract(context.Background(), common.Address{}, nil, nil)
+	require.ErrorIs(t, err, ErrNotReady)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int32(0), atomic.LoadInt32(&backend.stopped))
+
+	close(backend.release)
+	require.NoError(t, <-called)
+	<-stopped
+	require.Equal(t, int32(1), atomic.LoadInt32(&backend.stopped))
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	MetricsNamespace = "chain_client"
+)
+
+// Metrics collects the behaviour of BlockchainClient. Every method is nil safe, so a client without metrics skips them.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests     *prometheus.CounterVec
+	errors       *prometheus.CounterVec
+	latency      *prometheus.HistogramVec
+	gasUsed      *prometheus.HistogramVec
+	confirmation *prometheus.HistogramVec
+	pending      prometheus.Gauge
+	nonceGaps    prometheus.Counter
+	cacheLookups *prometheus.CounterVec
+}
+
+// NewMetrics creates the collectors on a dedicated registry, exposed by Handler.
+// Several clients may share the same Metrics.
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "requests_total",
+			Help:      "Number of BlockchainClient method calls.",
+		}, []string{"method"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "errors_total",
+			Help:      "Number of failed BlockchainClient method calls by error category.",
+		}, []string{"method", "category"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of BlockchainClient method calls, including the wait for confirmation of sync sends.",
+			Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+		}, []string{"method"}),
+		gasUsed: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "gas_used",
+			Help:      "Gas used by the transactions sent by BlockchainClient methods.",
+			Buckets:   prometheus.ExponentialBuckets(21000, 2, 10),
+		}, []string{"method"}),
+		confirmation: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "confirmation_duration_seconds",
+			Help:      "Time from broadcast to outcome of async transactions.",
+			Buckets:   prometheus.ExponentialBuckets(0.5, 2, 10),
+		}, []string{"method", "status"}),
+		pending: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "pending_transactions",
+			Help:      "Number of async transactions waiting for their outcome.",
+		}),
+		nonceGaps: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "nonce_gaps_total",
+			Help:      "Number of async transactions dropped before being mined, each one leaving a gap in the sender nonces.",
+		}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "cache_lookups_total",
+			Help:      "Number of contract reads looked up in the read cache, by method and result (hit or miss).",
+		}, []string{"method", "result"}),
+	}
+
+	m.registry.MustRegister(m.requests, m.errors, m.latency, m.gasUsed, m.confirmation, m.pending, m.nonceGaps, m.cacheLookups)
+	return m
+}
+
+// Registry allows to register further collectors, or to gather the metrics into another registry.
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) observeCall(method string, elapsed time.Duration, err error) {
+	if m == nil {
+		return
+	}
+
+	m.requests.WithLabelValues(method).Inc()
+	m.latency.WithLabelValues(method).Observe(elapsed.Seconds())
+	if err != nil {
+		m.errors.WithLabelValues(method, ErrorCategory(err)).Inc()
+	}
+}
+
+func (m *Metrics) observeGas(method string, gas uint64) {
+	if m == nil {
+		return
+	}
+
+	m.gasUsed.WithLabelValues(method).Observe(float64(gas))
+}
+
+func (m *Metrics) txSent() {
+	if m == nil {
+		return
+	}
+
+	m.pending.Inc()
+}
+
+func (m *Metrics) observeTx(r TxResult) {
+	if m == nil {
+		return
+	}
+
+	m.pending.Dec()
+	m.confirmation.WithLabelValues(r.Method, string(r.Status)).Observe(r.DoneAt.Sub(r.SentAt).Seconds())
+	switch r.Status {
+	case TxDropped:
+		m.nonceGaps.Inc()
+	default:
+		m.gasUsed.WithLabelValues(r.Method).Observe(float64(r.GasUsed))
+	}
+}
+
+func (m *Metrics) observeCache(method string, hit bool) {
+	if m == nil {
+		return
+	}
+
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cacheLookups.WithLabelValues(method, result).Inc()
+}
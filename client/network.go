@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrChainIDMismatch = errors.New("chain id mismatch")
+	ErrUnknownNetwork  = errors.New("unknown network")
+)
+
+// FeeStrategy sets the fees of the transactions.
+//
+// Only legacy transactions are priced by it: on a node supporting EIP-1559, eth-extended-client always sends
+// dynamic fee transactions with the tip suggested by the node and a fee cap of twice the base fee plus the tip,
+// and GasPrice is not used. The tip and the fee cap can't be set.
+type FeeStrategy struct {
+	GasPrice int64 `json:"gas_price" yaml:"gas_price"` // in wei, legacy transactions only
+}
+
+// NetworkProfile describes a network the client is allowed to talk to.
+type NetworkProfile struct {
+	Name              string      `json:"name" yaml:"name"`
+	Endpoints         []Endpoint  `json:"endpoints" yaml:"endpoints"`
+	ChainID           uint64      `json:"chain_id" yaml:"chain_id"`
+	ConfirmationBlock uint64      `json:"confirmation_block" yaml:"confirmation_block"`
+	Fee               FeeStrategy `json:"fee" yaml:"fee"`
+	FactoryAddress    string      `json:"factory_address" yaml:"factory_address"`       // default of the factory requests
+	ComplianceAddress string      `json:"compliance_address" yaml:"compliance_address"` // default of the compliance requests and DeploySecurityToken
+}
+
+func (p NetworkProfile) Validate() error {
+	if p.ChainID == 0 {
+		return errors.Errorf("chain_id of network(=%s) is missing", p.Name)
+	}
+
+	for _, e := range p.Endpoints {
+		if e.URL == "" {
+			return errors.Errorf("endpoint of network(=%s) without url", p.Name)
+		}
+	}
+
+	for _, addr := range []string{p.FactoryAddress, p.ComplianceAddress} {
+		if addr != "" && !common.IsHexAddress(addr) {
+			return errors.Errorf("invalid address(=%s) in network(=%s)", addr, p.Name)
+		}
+	}
+	return nil
+}
+
+// LoadNetworkProfiles reads the profiles keyed by name from a YAML or JSON file.
+func LoadNetworkProfiles(path string) (profiles map[string]NetworkProfile, err error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read network profiles(=%s)", path)
+	}
+
+	// JSON being valid YAML, the same decoder handles both
+	if err = yaml.Unmarshal(b, &profiles); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse network profiles(=%s)", path)
+	}
+
+	for name, p := range profiles {
+		if p.Name == "" {
+			p.Name = name
+			profiles[name] = p
+		}
+		if err = p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// LoadNetworkProfile reads the profile named name, see LoadNetworkProfiles.
+func LoadNetworkProfile(path, name string) (NetworkProfile, error) {
+	profiles, err := LoadNetworkProfiles(path)
+	if err != nil {
+		return NetworkProfile{}, err
+	}
+
+	p, ok := profiles[name]
+	if !ok {
+		return NetworkProfile{}, errors.Wrapf(ErrUnknownNetwork, "network(=%s) not in %s", name, path)
+	}
+	return p, nil
+}
+
+type chainIDReader interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// verifyChainID makes sure the backend serves the chain of the network profile.
+func (c *BlockchainClient) verifyChainID(ctx context.Context, backend ChainBackend) error {
+	r, ok := backend.(chainIDReader)
+	if !ok {
+		return errors.New("backend can not tell its chain id")
+	}
+
+	id, err := r.ChainID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain id")
+	}
+
+	if id.Uint64() != c.network.ChainID {
+		return errors.Wrapf(ErrChainIDMismatch, "network=%s, expected=%d, actual=%s", c.network.Name, c.network.ChainID, id)
+	}
+	return nil
+}
+
+// defaultFactory fills the factory address of the network profile when the request leaves it empty.
+func (c *BlockchainClient) defaultFactory(address *string) {
+	if *address == "" && c.network != nil {
+		*address = c.network.FactoryAddress
+	}
+}
+
+// defaultCompliance fills the compliance address of the network profile when the request leaves it empty.
+func (c *BlockchainClient) defaultCompliance(address *string) {
+	if *address == "" && c.network != nil {
+		*address = c.network.ComplianceAddress
+	}
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ango-ya/chain-client/data"
+	"github.com/stretchr/testify/require"
+)
+
+const TestNetworkYAML = `
+local:
+  chain_id: 1337
+  confirmation_block: 2
+  fee:
+    gas_price: 1000000000
+  endpoints:
+    - url: http://127.0.0.1:8545
+      priority: 1
+    - url: http://127.0.0.1:8546
+  compliance_address: "0x0000000000000000000000000000000000000001"
+mainnet:
+  name: ethereum
+  chain_id: 1
+`
+
+const TestNetworkJSON = `{"local": {"chain_id": 1337, "endpoints": [{"url": "http://127.0.0.1:8545"}]}}`
+
+func TestLoadNetworkProfiles(t *testing.T) {
+	dir := t.TempDir()
+	yamlPath := filepath.Join(dir, "networks.yaml")
+	jsonPath := filepath.Join(dir, "networks.json")
+	require.NoError(t, os.WriteFile(yamlPath, []byte(TestNetworkYAML), 0600))
+	require.NoError(t, os.WriteFile(jsonPath, []byte(TestNetworkJSON), 0600))
+
+	profiles, err := LoadNetworkProfiles(yamlPath)
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+
+	local := profiles["local"]
+	require.Equal(t, "local", local.Name)
+	require.Equal(t, uint64(1337), local.ChainID)
+	require.Equal(t, uint64(2), local.ConfirmationBlock)
+	require.Equal(t, int64(1000000000), local.Fee.GasPrice)
+	require.Equal(t, []Endpoint{{URL: "http://127.0.0.1:8545", Priority: 1}, {URL: "http://127.0.0.1:8546"}}, local.Endpoints)
+	require.Equal(t, "ethereum", profiles["mainnet"].Name)
+
+	local, err = LoadNetworkProfile(jsonPath, "local")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1337), local.ChainID)
+
+	_, err = LoadNetworkProfile(jsonPath, "mainnet")
+	require.ErrorIs(t, err, ErrUnknownNetwork)
+
+	// chain_idは必須
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"local": {"endpoints": [{"url": "http://127.0.0.1:8545"}]}}`), 0600))
+	_, err = LoadNetworkProfiles(jsonPath)
+	require.Error(t, err)
+}
+
+func TestWithNetworkProfile(t *testing.T) {
+	c, err := NewBlockchainClient(TestEndpoint, WithTimeout(3), WithNetworkProfile(NetworkProfile{Name: "local", ChainID: 1337, FactoryAddress: TestComplianceAddress}))
+	require.NoError(t, err)
+	network, ok := c.Network()
+	require.True(t, ok)
+	require.Equal(t, TestComplianceAddress, network.FactoryAddress)
+
+	// 異なるチェーンには接続しない
+	_, err = NewBlockchainClient(TestEndpoint, WithTimeout(3), WithNetworkProfile(NetworkProfile{Name: "mainnet", ChainID: 1}))
+	require.ErrorIs(t, err, ErrChainIDMismatch)
+
+	_, err = NewBlockchainClient("", WithTimeout(3), WithNetworkProfile(NetworkProfile{
+		Name:      "mainnet",
+		ChainID:   1,
+		Endpoints: []Endpoint{{URL: TestEndpoint}, {URL: DeadEndpoint}},
+	}))
+	require.ErrorIs(t, err, ErrChainIDMismatch)
+
+	c, err = NewBlockchainClient("", WithTimeout(3), WithNetworkProfile(NetworkProfile{
+		Name:              "local",
+		ChainID:           1337,
+		Endpoints:         []Endpoint{{URL: TestEndpoint}},
+		ComplianceAddress: TestComplianceAddress,
+	}))
+	require.NoError(t, err)
+	require.Len(t, c.Endpoints(), 1)
+	c.Start()
+	defer c.Close()
+
+	// 省略したアドレスはプロファイルのものを使う
+	res, err := c.ContainsWallet(context.Background(), data.ContainsWalletRequest{Account: TestAccount4})
+	require.NoError(t, err)
+	require.True(t, res.GetRegistered())
+}
@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ango-ya/chain-client/contract"
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// the steps of OnboardInvestor, in order
+const (
+	StepRegisterWallet   = "register_wallet"
+	StepValidateIssuance = "validate_issuance"
+	StepIssue            = "issue"
+)
+
+var (
+	// ErrIssuanceRejected is returned when validateIssuance of the compliance service refuses the issuance, with its reason.
+	ErrIssuanceRejected = errors.New("issuance rejected by the compliance service")
+	// ErrIssuePending is returned when the issuance of the previous attempt is not mined yet, retry later rather than issuing again.
+	ErrIssuePending = errors.New("issuance of the previous attempt not mined yet")
+	// ErrIssueMismatch is returned when the previous attempt did not issue the amount to the account.
+	ErrIssueMismatch = errors.New("transaction is not the issuance of the request")
+)
+
+// OnboardInvestor registers the account in the compliance service of the token unless registered already,
+// validates the issuance with the compliance service, then issues the amount to the account.
+// The steps are reported in the response, also when failing.
+//
+// A failed call can be retried with the same request, the steps already done being told from the chain state:
+// the registration is skipped once the account is registered, and the issuance once the account holds the amount.
+// When the previous attempt returned an issuance not mined yet, pass it as IssueHash, so that the retry waits for it
+// rather than issuing again.
+func (c *BlockchainClient) OnboardInvestor(ctx context.Context, req data.OnboardInvestorRequest) (resp data.OnboardInvestorResponse, err error) {
+	ctx, call := c.begin(ctx, "OnboardInvestor", data.RequestType_ONBOARD_INVESTOR, &req)
+	defer c.end(call, &err)
+
+	var (
+		register = &data.OnboardingStep{Name: StepRegisterWallet}
+		validate = &data.OnboardingStep{Name: StepValidateIssuance}
+		issue    = &data.OnboardingStep{Name: StepIssue}
+		fail     = func(step *data.OnboardingStep, e error) error {
+			step.Status, step.Error = data.StepStatus_STEP_FAILED, e.Error()
+			return e
+		}
+	)
+	resp.Steps = []*data.OnboardingStep{register, validate, issue}
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	var (
+		token   = common.HexToAddress(req.GetContractAddress())
+		account = common.HexToAddress(req.GetAccount())
+		key, _  = crypto.HexToECDSA(req.GetPrivateKey())
+	)
+	amount, err := c.toBaseUnits(ctx, token, req.GetAmount())
+	if err != nil {
+		return
+	}
+
+	var compliance common.Address
+	if err = c.query(ctx, c.stABI, token, nil, &compliance, "nowCompliance"); err != nil {
+		return
+	}
+	resp.ComplianceAddress = compliance.String()
+
+	// register
+	var registered bool
+	if err = c.query(ctx, c.csABI, compliance, nil, &registered, "containsWallet", account); err != nil {
+		err = fail(register, err)
+		return
+	}
+	if registered {
+		register.Status = data.StepStatus_STEP_SKIPPED
+	} else {
+		res, rerr := c.RegisterWalletComplianceService(ctx, data.RegisterWalletRequest{
+			PrivateKey:      req.GetPrivateKey(),
+			ContractAddress: resp.ComplianceAddress,
+			Account:         req.GetAccount(),
+			GasLimit:        req.GetGasLimit(),
+		})
+		register.Hash = res.GetHash()
+		if rerr != nil {
+			err = fail(register, rerr)
+			return
+		}
+		register.Status = data.StepStatus_STEP_DONE
+	}
+
+	// the previous attempt
+	if hash := req.GetIssueHash(); hash != "" {
+		issue.Hash = hash
+
+		done, ierr := c.issued(ctx, hash, token, account, amount)
+		if ierr != nil {
+			err = fail(issue, ierr)
+			return
+		}
+		if done {
+			validate.Status, issue.Status = data.StepStatus_STEP_SKIPPED, data.StepStatus_STEP_SKIPPED
+			resp.IssueHash, resp.Completed = hash, true
+			return
+		}
+		issue.Hash = ""
+	}
+
+	// the issuance of a previous attempt, mined after it timed out
+	var balance *big.Int
+	if err = c.query(ctx, c.stABI, token, nil, &balance, "balanceOf", account); err != nil {
+		err = fail(issue, err)
+		return
+	}
+	if balance.Cmp(amount) >= 0 {
+		validate.Status, issue.Status = data.StepStatus_STEP_SKIPPED, data.StepStatus_STEP_SKIPPED
+		resp.Completed = true
+		return
+	}
+
+	// validate
+	if err = c.validateIssuance(ctx, compliance, crypto.PubkeyToAddress(key.PublicKey), account, amount); err != nil {
+		err = fail(validate, err)
+		return
+	}
+	validate.Status = data.StepStatus_STEP_DONE
+
+	// issue
+	res, err := c.IssueSecurityToken(ctx, data.IssueRequest{
+		PrivateKey:      req.GetPrivateKey(),
+		ContractAddress: req.GetContractAddress(),
+		Recipient:       req.GetAccount(),
+		Amount:          req.GetAmount(),
+		GasLimit:        req.GetGasLimit(),
+	})
+	issue.Hash, resp.IssueHash = res.GetHash(), res.GetHash()
+	if err != nil {
+		err = fail(issue, err)
+		return
+	}
+	issue.Status, resp.Completed = data.StepStatus_STEP_DONE, true
+
+	c.logger.Info().Msgf("investor onboarded, amount=%s, account=%s, contract=%s", req.GetAmount(), req.GetAccount(), req.GetContractAddress())
+	return
+}
+
+// issued tells if the transaction issued the amount of the token to the account, false when it reverted.
+// A transaction not mined yet fails with ErrIssuePending, so that it is not issued twice.
+func (c *BlockchainClient) issued(ctx context.Context, hash string, token, account common.Address, amount *big.Int) (bool, error) {
+	receipt, err := c.receipt(ctx, hash)
+	switch {
+	case errors.Is(err, ErrTxReverted):
+		return false, nil
+	case errors.Is(err, ethereum.NotFound):
+		return false, errors.Wrapf(ErrIssuePending, "hash=%s", hash)
+	case err != nil:
+		return false, errors.Wrapf(err, "failed to get the receipt of the previous issuance(=%s)", hash)
+	}
+
+	if !c.hasIssuedEvent(token, account, amount, receipt) {
+		return false, invalidRequest(errors.Wrapf(ErrIssueMismatch, "hash=%s", hash))
+	}
+	return true, nil
+}
+
+// hasIssuedEvent finds the Issued event of the token to the account among the logs of the receipt.
+func (c *BlockchainClient) hasIssuedEvent(token, account common.Address, amount *big.Int, receipt *types.Receipt) bool {
+	var (
+		topic   = c.stABI.Topics["Issued"]
+		indexed abi.Arguments
+	)
+	for _, arg := range c.stABI.Events["Issued"].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != token || len(log.Topics) == 0 || log.Topics[0] != topic {
+			continue
+		}
+		var event contract.SecurityTokenIssued
+		if err := c.stABI.UnpackIntoInterface(&event, "Issued", log.Data); err != nil {
+			continue
+		}
+		if err := abi.ParseTopics(&event, indexed, log.Topics[1:]); err != nil {
+			continue
+		}
+		if event.Recipient == account && event.Amount.Cmp(amount) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// validateIssuance asks the compliance service if the operator may issue the amount to the account.
+func (c *BlockchainClient) validateIssuance(ctx context.Context, compliance, operator, account common.Address, amount *big.Int) error {
+	input, err := pack(&c.csABI.ABI, "validateIssuance", operator, account, amount)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.callContract(ctx, compliance, input, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to query contract(=%s), input(=%v)", compliance.String(), input)
+	}
+
+	values, err := c.csABI.Unpack("validateIssuance", output)
+	if err != nil || len(values) != 2 {
+		return errors.Wrapf(ErrUnexpectedOutput, "failed to unpack validateIssuance: contract(=%s)", compliance.String())
+	}
+
+	ok, _ := values[0].(bool)
+	reason, _ := values[1].(string)
+	if !ok {
+		return errors.Wrapf(ErrIssuanceRejected, "reason=%q, operator=%s, account=%s", reason, operator.String(), account.String())
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnboardInvestor(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		ctx     = context.Background()
+		c, _    = NewBlockchainClient(TestEndpoint, WithTimeout(3))
+		account = crypto.PubkeyToAddress(key.PublicKey).String()
+		req     = data.OnboardInvestorRequest{
+			PrivateKey:      TestPrivKey2,
+			ContractAddress: TestSecurityTokenAddress,
+			Account:         account,
+			Amount:          "10",
+		}
+		balReq      = data.BalanceOfRequest{ContractAddress: TestSecurityTokenAddress, Account: account}
+		expected, _ = data.ToWei("10", 18)
+	)
+	c.Start()
+	defer c.Close()
+
+	// 登録と発行
+	resp, err := c.OnboardInvestor(ctx, req)
+	require.NoError(t, err)
+	require.True(t, resp.GetCompleted())
+	require.Equal(t, TestComplianceAddress, resp.GetComplianceAddress())
+	require.Equal(t, []data.StepStatus{data.StepStatus_STEP_DONE, data.StepStatus_STEP_DONE, data.StepStatus_STEP_DONE}, stepStatuses(resp))
+	require.NotEmpty(t, resp.Steps[0].GetHash())
+	require.Equal(t, resp.GetIssueHash(), resp.Steps[2].GetHash())
+
+	walletRes, err := c.ContainsWallet(ctx, data.ContainsWalletRequest{ContractAddress: TestComplianceAddress, Account: account})
+	require.NoError(t, err)
+	require.True(t, walletRes.GetRegistered())
+	balRes, err := c.BalanceOfSecurityToken(ctx, balReq)
+	require.NoError(t, err)
+	require.Equal(t, expected.String(), balRes.GetAmount())
+
+	// 前回のハッシュを渡すと再発行しない
+	req.IssueHash = resp.GetIssueHash()
+	retry, err := c.OnboardInvestor(ctx, req)
+	require.NoError(t, err)
+	require.True(t, retry.GetCompleted())
+	require.Equal(t, resp.GetIssueHash(), retry.GetIssueHash())
+	require.Equal(t, []data.StepStatus{data.StepStatus_STEP_SKIPPED, data.StepStatus_STEP_SKIPPED, data.StepStatus_STEP_SKIPPED}, stepStatuses(retry))
+	balRes, err = c.BalanceOfSecurityToken(ctx, balReq)
+	require.NoError(t, err)
+	require.Equal(t, expected.String(), balRes.GetAmount())
+
+	// 発行でないトランザクションのハッシュは拒否される
+	req.IssueHash = resp.Steps[0].GetHash()
+	_, err = c.OnboardInvestor(ctx, req)
+	require.ErrorIs(t, err, ErrIssueMismatch)
+	require.Equal(t, CategoryValidation, ErrorCategory(err))
+
+	// 未採掘のハッシュでは発行しない
+	req.IssueHash = common.BytesToHash([]byte("unknown")).Hex()
+	pending, err := c.OnboardInvestor(ctx, req)
+	require.ErrorIs(t, err, ErrIssuePending)
+	require.Equal(t, CategoryPending, ErrorCategory(err))
+	require.False(t, pending.GetCompleted())
+	require.Equal(t, []data.StepStatus{data.StepStatus_STEP_SKIPPED, data.StepStatus_STEP_PENDING, data.StepStatus_STEP_FAILED}, stepStatuses(pending))
+	require.NotEmpty(t, pending.Steps[2].GetError())
+
+	// ハッシュなしの再試行も、発行済みなら再発行しない
+	req.IssueHash = ""
+	again, err := c.OnboardInvestor(ctx, req)
+	require.NoError(t, err)
+	require.True(t, again.GetCompleted())
+	require.Equal(t, []data.StepStatus{data.StepStatus_STEP_SKIPPED, data.StepStatus_STEP_SKIPPED, data.StepStatus_STEP_SKIPPED}, stepStatuses(again))
+	balRes, err = c.BalanceOfSecurityToken(ctx, balReq)
+	require.NoError(t, err)
+	require.Equal(t, expected.String(), balRes.GetAmount())
+
+	// 残高が足りなければ発行する
+	req.Amount = "20"
+	more, err := c.OnboardInvestor(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []data.StepStatus{data.StepStatus_STEP_SKIPPED, data.StepStatus_STEP_DONE, data.StepStatus_STEP_DONE}, stepStatuses(more))
+	require.NotEqual(t, resp.GetIssueHash(), more.GetIssueHash())
+	balRes, err = c.BalanceOfSecurityToken(ctx, balReq)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Mul(expected, big.NewInt(3)).String(), balRes.GetAmount())
+
+	// 権限のない発行者はコンプライアンスに拒否される
+	req.PrivateKey, req.Amount = TestPrivKey3, "100"
+	rejected, err := c.OnboardInvestor(ctx, req)
+	require.ErrorIs(t, err, ErrIssuanceRejected)
+	require.Equal(t, CategoryRejected, ErrorCategory(err))
+	require.Equal(t, []data.StepStatus{data.StepStatus_STEP_SKIPPED, data.StepStatus_STEP_FAILED, data.StepStatus_STEP_PENDING}, stepStatuses(rejected))
+
+	// 取り消された登録は失敗とする
+	key, err = crypto.GenerateKey()
+	require.NoError(t, err)
+	req.Account, req.GasLimit = crypto.PubkeyToAddress(key.PublicKey).String(), 300000
+	reverted, err := c.OnboardInvestor(ctx, req)
+	require.ErrorIs(t, err, ErrTxReverted)
+	require.False(t, reverted.GetCompleted())
+	require.Equal(t, []data.StepStatus{data.StepStatus_STEP_FAILED, data.StepStatus_STEP_PENDING, data.StepStatus_STEP_PENDING}, stepStatuses(reverted))
+	require.NotEmpty(t, reverted.Steps[0].GetHash())
+}
+
+func stepStatuses(resp data.OnboardInvestorResponse) (statuses []data.StepStatus) {
+	for _, step := range resp.GetSteps() {
+		statuses = append(statuses, step.GetStatus())
+	}
+	return
+}
@@ -2,12 +2,22 @@ package client
 
 import (
 	"os"
+	"time"
 
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	DefaultTimeout = int64(30) // 30 sec
+	DefaultTimeout     = int64(30) // 30 sec
+	DefaultDialTimeout = int64(8)  // 8 sec
+
+	DefaultConfirmWorkers          = 1
+	DefaultConfirmWorkerInterval   = int64(32)  // 32 ms
+	DefaultConfirmationInterval    = int64(1)   // 1 sec
+	DefaultSyncSendConfirmInterval = int64(128) // 128 ms
 )
 
 var DefaultLogger = zerolog.New(os.Stderr).Level(zerolog.InfoLevel).With().Timestamp().Logger()
@@ -21,6 +31,8 @@ type TimeoutOpt int64
 func (t TimeoutOpt) Apply(c *BlockchainClient) {
 	c.timeout = int64(t)
 }
+
+// WithTimeout is the default of WithRPCTimeout and WithConfirmTimeout, in sec.
 func WithTimeout(t int64) TimeoutOpt {
 	if t <= 0 {
 		panic("Timeout should be positive")
@@ -28,6 +40,147 @@ func WithTimeout(t int64) TimeoutOpt {
 	return TimeoutOpt(t)
 }
 
+type DialTimeoutOpt int64
+
+func (t DialTimeoutOpt) Apply(c *BlockchainClient) {
+	c.timeouts.dial = time.Duration(t) * time.Second
+}
+
+// WithDialTimeout bounds the connection to the node in NewBlockchainClient.
+func WithDialTimeout(t int64) DialTimeoutOpt {
+	if t <= 0 {
+		panic("DialTimeout should be positive")
+	}
+	return DialTimeoutOpt(t)
+}
+
+type RPCTimeoutOpt int64
+
+func (t RPCTimeoutOpt) Apply(c *BlockchainClient) {
+	c.timeouts.rpc = time.Duration(t) * time.Second
+}
+
+// WithRPCTimeout bounds the methods called without a deadline, sends excluding the wait for confirmation. Defaults to WithTimeout.
+func WithRPCTimeout(t int64) RPCTimeoutOpt {
+	if t <= 0 {
+		panic("RPCTimeout should be positive")
+	}
+	return RPCTimeoutOpt(t)
+}
+
+type ConfirmTimeoutOpt int64
+
+func (t ConfirmTimeoutOpt) Apply(c *BlockchainClient) {
+	c.timeouts.confirm = time.Duration(t) * time.Second
+}
+
+// WithConfirmTimeout bounds the wait for the confirmation of sync sends, and the tracking of async ones. Defaults to WithTimeout.
+func WithConfirmTimeout(t int64) ConfirmTimeoutOpt {
+	if t <= 0 {
+		panic("ConfirmTimeout should be positive")
+	}
+	return ConfirmTimeoutOpt(t)
+}
+
+type MethodTimeoutOpt struct {
+	method  string
+	timeout time.Duration
+}
+
+func (o MethodTimeoutOpt) Apply(c *BlockchainClient) {
+	if c.timeouts.methods == nil {
+		c.timeouts.methods = make(map[string]time.Duration)
+	}
+	c.timeouts.methods[o.method] = o.timeout
+}
+
+// WithMethodTimeout bounds the method called without a deadline, in sec, overriding WithRPCTimeout and WithConfirmTimeout.
+// OnboardInvestor defaults to twice their sum, as it sends up to two transactions.
+func WithMethodTimeout(method string, t int64) MethodTimeoutOpt {
+	if t <= 0 {
+		panic("MethodTimeout should be positive")
+	}
+	return MethodTimeoutOpt{method: method, timeout: time.Duration(t) * time.Second}
+}
+
+// Sent transactions are queued to the confirmer. Every worker interval, each worker takes one transaction
+// from the queue, and checks its receipt if it was not checked within the confirmation interval. The transaction
+// is confirmed once the confirmation block count is mined on top of it, otherwise it is queued again.
+// A sync send polls the receipt of its transaction every sync send confirm interval, until confirmed the same way.
+//
+// So a single worker checks at most 1000/WorkerInterval transactions per sec, and a sync send takes at least
+// ConfirmationBlock block times, all of which must fit into WithConfirmTimeout.
+
+type ConfirmWorkersOpt int
+
+func (o ConfirmWorkersOpt) Apply(c *BlockchainClient) {
+	c.confirmer.workers = int(o)
+}
+
+// WithConfirmWorkers sets how many transactions are checked in parallel, to keep up with high throughput.
+func WithConfirmWorkers(n int) ConfirmWorkersOpt {
+	if n <= 0 {
+		panic("ConfirmWorkers should be positive")
+	}
+	return ConfirmWorkersOpt(n)
+}
+
+type ConfirmWorkerIntervalOpt int64
+
+func (o ConfirmWorkerIntervalOpt) Apply(c *BlockchainClient) {
+	c.confirmer.workerInterval = int64(o)
+}
+
+// WithConfirmWorkerInterval sets how often, in ms, each worker takes a transaction from the queue.
+func WithConfirmWorkerInterval(ms int64) ConfirmWorkerIntervalOpt {
+	if ms <= 0 {
+		panic("ConfirmWorkerInterval should be positive")
+	}
+	return ConfirmWorkerIntervalOpt(ms)
+}
+
+type ConfirmationIntervalOpt int64
+
+func (o ConfirmationIntervalOpt) Apply(c *BlockchainClient) {
+	c.confirmer.confirmationInterval = int64(o)
+}
+
+// WithConfirmationInterval sets the minimum time, in sec, between two checks of the same transaction.
+// 0 checks it every time it is taken from the queue.
+func WithConfirmationInterval(sec int64) ConfirmationIntervalOpt {
+	if sec < 0 {
+		panic("ConfirmationInterval should not be negative")
+	}
+	return ConfirmationIntervalOpt(sec)
+}
+
+type ConfirmationBlockOpt uint64
+
+func (o ConfirmationBlockOpt) Apply(c *BlockchainClient) {
+	n := uint64(o)
+	c.confirmer.confirmationBlock = &n
+}
+
+// WithConfirmationBlock sets how many blocks are mined on top of a transaction before it is confirmed.
+// It overrides the confirmation block of WithNetworkProfile, 0 being the default.
+func WithConfirmationBlock(n uint64) ConfirmationBlockOpt {
+	return ConfirmationBlockOpt(n)
+}
+
+type SyncSendConfirmIntervalOpt int64
+
+func (o SyncSendConfirmIntervalOpt) Apply(c *BlockchainClient) {
+	c.confirmer.syncSendInterval = int64(o)
+}
+
+// WithSyncSendConfirmInterval sets how often, in ms, a sync send polls whether its transaction is confirmed.
+func WithSyncSendConfirmInterval(ms int64) SyncSendConfirmIntervalOpt {
+	if ms <= 0 {
+		panic("SyncSendConfirmInterval should be positive")
+	}
+	return SyncSendConfirmIntervalOpt(ms)
+}
+
 type LoggerOpt zerolog.Logger
 
 func (o LoggerOpt) Apply(c *BlockchainClient) {
@@ -36,3 +189,216 @@ func (o LoggerOpt) Apply(c *BlockchainClient) {
 func WithLoggerOpt(logger zerolog.Logger) LoggerOpt {
 	return LoggerOpt(logger)
 }
+
+type TxResultHandlerOpt TxResultHandler
+
+func (o TxResultHandlerOpt) Apply(c *BlockchainClient) {
+	c.tracker.handlers = append(c.tracker.handlers, TxResultHandler(o))
+}
+
+// WithTxResultHandler registers a handler receiving the outcome of every transaction sent asynchronously.
+// The handler is called from the tracking goroutine, so it should not block.
+func WithTxResultHandler(h TxResultHandler) TxResultHandlerOpt {
+	if h == nil {
+		panic("TxResultHandler should not be nil")
+	}
+	return TxResultHandlerOpt(h)
+}
+
+type BackendOpt struct {
+	backend ChainBackend
+}
+
+func (o BackendOpt) Apply(c *BlockchainClient) {
+	c.backend = o.backend
+}
+
+// WithBackend replaces the default eth-extended-client backend, the endpoint is not dialed then.
+func WithBackend(backend ChainBackend) BackendOpt {
+	if backend == nil {
+		panic("ChainBackend should not be nil")
+	}
+	return BackendOpt{backend}
+}
+
+type MetricsOpt struct {
+	metrics *Metrics
+}
+
+func (o MetricsOpt) Apply(c *BlockchainClient) {
+	c.metrics = o.metrics
+	c.tracker.handlers = append(c.tracker.handlers, o.metrics.observeTx)
+}
+
+// WithMetrics enables the metrics, see NewMetrics.
+func WithMetrics(metrics *Metrics) MetricsOpt {
+	if metrics == nil {
+		panic("Metrics should not be nil")
+	}
+	return MetricsOpt{metrics}
+}
+
+type TracerProviderOpt struct {
+	provider trace.TracerProvider
+}
+
+func (o TracerProviderOpt) Apply(c *BlockchainClient) {
+	c.tracer = o.provider.Tracer(TracerName)
+	c.tracker.handlers = append(c.tracker.handlers, traceTx(c.tracer))
+}
+
+// WithTracerProvider enables tracing, a span is started for every method and every RPC it makes.
+// Pass otel.GetTracerProvider() to use the global provider.
+func WithTracerProvider(provider trace.TracerProvider) TracerProviderOpt {
+	if provider == nil {
+		panic("TracerProvider should not be nil")
+	}
+	return TracerProviderOpt{provider}
+}
+
+type AuditSinkOpt struct {
+	sink AuditSink
+}
+
+func (o AuditSinkOpt) Apply(c *BlockchainClient) {
+	c.auditSink = o.sink
+}
+
+// WithAuditSink records every privileged operation, see NewFileAuditSink for the default tamper evident log.
+func WithAuditSink(sink AuditSink) AuditSinkOpt {
+	if sink == nil {
+		panic("AuditSink should not be nil")
+	}
+	return AuditSinkOpt{sink}
+}
+
+type EndpointsOpt struct {
+	endpoints []Endpoint
+	opts      []FailoverOption
+}
+
+func (o EndpointsOpt) Apply(c *BlockchainClient) {
+	c.endpoints = o.endpoints
+	c.failoverOpts = o.opts
+}
+
+// WithEndpoints fails over between several nodes instead of dialing the endpoint given to NewBlockchainClient, see FailoverBackend.
+func WithEndpoints(endpoints []Endpoint, opts ...FailoverOption) EndpointsOpt {
+	if len(endpoints) == 0 {
+		panic("Endpoints should not be empty")
+	}
+	return EndpointsOpt{endpoints, opts}
+}
+
+type NetworkProfileOpt NetworkProfile
+
+func (o NetworkProfileOpt) Apply(c *BlockchainClient) {
+	p := NetworkProfile(o)
+	c.network = &p
+	if len(p.Endpoints) > 0 {
+		c.endpoints = p.Endpoints
+	}
+}
+
+// WithNetworkProfile connects to the endpoints of the profile, if any, and refuses to create the client
+// when the node serves another chain than the profile. The factory and compliance addresses of the profile
+// are used by the requests leaving theirs empty, see FeeStrategy for the fees.
+func WithNetworkProfile(p NetworkProfile) NetworkProfileOpt {
+	if err := p.Validate(); err != nil {
+		panic(err.Error())
+	}
+	return NetworkProfileOpt(p)
+}
+
+type ValidatePolicyOpt []data.ValidateOption
+
+func (o ValidatePolicyOpt) Apply(c *BlockchainClient) {
+	c.validateOpts = append(c.validateOpts, o...)
+}
+
+// WithValidatePolicy applies the options to the validation of every request, such as data.WithChecksum.
+func WithValidatePolicy(opts ...data.ValidateOption) ValidatePolicyOpt {
+	return ValidatePolicyOpt(opts)
+}
+
+type BatchSizeOpt int
+
+func (o BatchSizeOpt) Apply(c *BlockchainClient) {
+	c.batchSize = int(o)
+}
+
+// WithBatchSize sets how many calls BatchCall packs into a single request.
+func WithBatchSize(size int) BatchSizeOpt {
+	if size <= 0 {
+		panic("BatchSize should be positive")
+	}
+	return BatchSizeOpt(size)
+}
+
+type BatchConcurrencyOpt int
+
+func (o BatchConcurrencyOpt) Apply(c *BlockchainClient) {
+	c.batchConcurrency = int(o)
+}
+
+// WithBatchConcurrency sets how many requests BatchCall sends at once.
+func WithBatchConcurrency(n int) BatchConcurrencyOpt {
+	if n <= 0 {
+		panic("BatchConcurrency should be positive")
+	}
+	return BatchConcurrencyOpt(n)
+}
+
+type LogPageSizeOpt uint64
+
+func (o LogPageSizeOpt) Apply(c *BlockchainClient) {
+	c.logPageSize = uint64(o)
+}
+
+// WithLogPageSize sets how many blocks are scanned by a single request of the logs, as nodes limit the range.
+func WithLogPageSize(blocks uint64) LogPageSizeOpt {
+	if blocks == 0 {
+		panic("LogPageSize should be positive")
+	}
+	return LogPageSizeOpt(blocks)
+}
+
+type MulticallOpt string
+
+func (o MulticallOpt) Apply(c *BlockchainClient) {
+	c.multicall = &multicall{address: common.HexToAddress(string(o))}
+}
+
+// WithMulticall packs the calls of a chunk into a single call of Multicall3 at the address, usually Multicall3Address.
+// BatchCall falls back to JSON-RPC batch requests when no contract is deployed there.
+func WithMulticall(address string) MulticallOpt {
+	if !common.IsHexAddress(address) {
+		panic("Multicall should be a valid address")
+	}
+	return MulticallOpt(address)
+}
+
+type LazyConnectOpt []ReconnectOption
+
+func (o LazyConnectOpt) Apply(c *BlockchainClient) {
+	c.lazy = true
+	c.reconnectOpts = o
+}
+
+// WithLazyConnect builds the client without dialing, the client connects in background once started,
+// and reconnects whenever the connection is lost. See Ready and Health.
+func WithLazyConnect(opts ...ReconnectOption) LazyConnectOpt {
+	return LazyConnectOpt(opts)
+}
+
+type ReadCacheOpt []CacheOption
+
+func (o ReadCacheOpt) Apply(c *BlockchainClient) {
+	c.cache = newReadCache(o...)
+}
+
+// WithReadCache caches the view methods listed in DefaultCacheTTLs, read at the latest block.
+// The cached values are dropped on expiry, or as soon as the events making them stale are mined once the client started.
+func WithReadCache(opts ...CacheOption) ReadCacheOpt {
+	return ReadCacheOpt(opts)
+}
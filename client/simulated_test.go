@@ -0,0 +1,348 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/ango-ya/chain-client/contract"
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+const (
+	SimulatedGasLimit = uint64(300000000)
+)
+
+var (
+	// the accounts funded by `make chain`
+	TestPrivKeys = []string{
+		TestPrivKey,
+		TestPrivKey2,
+		TestPrivKey3,
+		TestPrivKey4,
+		"71c64befc3dfd761a94cdcd1ce3e7603ea19cccdde4ac3428818821863e60481",
+		"70d46b61473e44be3e4a438c8aa373a795eb8ee0155993776b51062c59353918",
+		"e503cceff655bfb9075de9ff5bb3aa84aec08cf426c5fa87c1bec65ab5b975bc",
+		"7663e0a3bb5b39b233726ea6d4939fb9477a8c24b4b289564f24fb8b651d4c25",
+		"27f5756660416f3f1469b296ee4ee579b9a19167a15337b44e34e1f8221f4bd7",
+		"432494ee8a9af04064bd4bee7419c7e7023ec9fcdb9d1b7a6d02289f62545dd7",
+	}
+	TestBalance, _ = new(big.Int).SetString("1000000000000000000000", 10)
+)
+
+// SimulatedChain serves a go-ethereum simulated backend over JSON-RPC, so that
+// BlockchainClient can be tested end to end without a live node.
+// Every transaction is mined as soon as it is received, like ganache does.
+type SimulatedChain struct {
+	Backend *backends.SimulatedBackend
+	Server  *httptest.Server
+
+	ComplianceAddress    common.Address
+	SecurityTokenAddress common.Address
+	FactoryAddress       common.Address
+}
+
+// NewSimulatedChain funds the test accounts, then deploys a ComplianceService, a SecurityToken and a FactoryV0
+// from the bundled bytecode. The second account is granted ST_CONTROL_ROLE and the third and fourth are registered as wallets.
+func NewSimulatedChain() (s *SimulatedChain, err error) {
+	alloc := make(core.GenesisAlloc)
+	for _, priv := range TestPrivKeys {
+		key, err := crypto.HexToECDSA(priv)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode private key")
+		}
+		alloc[crypto.PubkeyToAddress(key.PublicKey)] = core.GenesisAccount{Balance: TestBalance}
+	}
+
+	s = &SimulatedChain{Backend: backends.NewSimulatedBackend(alloc, SimulatedGasLimit)}
+	if err = s.deploy(); err != nil {
+		err = errors.Wrap(err, "failed to deploy contracts")
+		return
+	}
+
+	server := rpc.NewServer()
+	if err = server.RegisterName("eth", &simulatedService{s.Backend}); err != nil {
+		err = errors.Wrap(err, "failed to register service")
+		return
+	}
+	s.Server = httptest.NewServer(server)
+	return
+}
+
+func (s *SimulatedChain) URL() string {
+	return s.Server.URL
+}
+
+func (s *SimulatedChain) Close() {
+	s.Server.Close()
+	_ = s.Backend.Close()
+}
+
+func (s *SimulatedChain) deploy() (err error) {
+	admin, err := transactor(TestPrivKey)
+	if err != nil {
+		return
+	}
+
+	var compliance *contract.ComplianceService
+	if s.ComplianceAddress, _, compliance, err = contract.DeployComplianceService(admin, s.Backend); err != nil {
+		return errors.Wrap(err, "at DeployComplianceService")
+	}
+	s.Backend.Commit()
+
+	var role [32]byte
+	hexRole, _ := hex.DecodeString(data.ST_CONTROL_ROLE)
+	copy(role[:], hexRole)
+	if _, err = compliance.SetupRole(admin, role, crypto.PubkeyToAddress(mustKey(TestPrivKey2).PublicKey)); err != nil {
+		return errors.Wrap(err, "at SetupRole")
+	}
+	s.Backend.Commit()
+
+	for _, account := range []string{TestAccount3, TestAccount4} {
+		if _, err = compliance.RegisterWallet(admin, common.HexToAddress(account)); err != nil {
+			return errors.Wrapf(err, "at RegisterWallet(=%s)", account)
+		}
+		s.Backend.Commit()
+	}
+
+	supply, _ := data.ToWei("100", 18)
+	if s.SecurityTokenAddress, _, _, err = contract.DeploySecurityToken(admin, s.Backend, "Test Token Name", "TKN", supply, s.ComplianceAddress); err != nil {
+		return errors.Wrap(err, "at DeploySecurityToken")
+	}
+	s.Backend.Commit()
+
+	if s.FactoryAddress, _, _, err = contract.DeployFactoryV0(admin, s.Backend); err != nil {
+		return errors.Wrap(err, "at DeployFactoryV0")
+	}
+	s.Backend.Commit()
+	return
+}
+
+func transactor(priv string) (*bind.TransactOpts, error) {
+	key, err := crypto.HexToECDSA(priv)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode private key")
+	}
+	return bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+}
+
+func mustKey(priv string) *ecdsa.PrivateKey {
+	key, err := crypto.HexToECDSA(priv)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// simulatedService implements the subset of the eth namespace used by the clients.
+type simulatedService struct {
+	b *backends.SimulatedBackend
+}
+
+type callArgs struct {
+	From     *common.Address `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     *hexutil.Bytes  `json:"data"`
+	Input    *hexutil.Bytes  `json:"input"`
+}
+
+func (args callArgs) msg() (msg ethereum.CallMsg) {
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	msg.To = args.To
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	msg.GasPrice = (*big.Int)(args.GasPrice)
+	msg.Value = (*big.Int)(args.Value)
+	if args.Input != nil {
+		msg.Data = *args.Input
+	} else if args.Data != nil {
+		msg.Data = *args.Data
+	}
+	return
+}
+
+// blockNumber converts the rpc block number into what the simulated backend expects, nil being the latest block.
+func blockNumber(number rpc.BlockNumber) *big.Int {
+	if number < 0 {
+		return nil
+	}
+	return big.NewInt(number.Int64())
+}
+
+func (s *simulatedService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(s.b.Blockchain().Config().ChainID)
+}
+
+func (s *simulatedService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.b.Blockchain().CurrentHeader().Number.Uint64())
+}
+
+// simulatedBlockNumber is a rpc.BlockNumber also accepting "safe". Every block is final on the simulated chain.
+type simulatedBlockNumber rpc.BlockNumber
+
+func (n *simulatedBlockNumber) UnmarshalJSON(input []byte) error {
+	if strings.Trim(string(input), `"`) == "safe" {
+		*n = simulatedBlockNumber(rpc.FinalizedBlockNumber)
+		return nil
+	}
+	return (*rpc.BlockNumber)(n).UnmarshalJSON(input)
+}
+
+func (s *simulatedService) GetBlockByNumber(ctx context.Context, number simulatedBlockNumber, full bool) (*types.Header, error) {
+	return s.b.HeaderByNumber(ctx, blockNumber(rpc.BlockNumber(number)))
+}
+
+// pastState is the state at a block before the latest one, which the simulated backend can't read by itself.
+// The header is nil for the latest and pending blocks.
+func (s *simulatedService) pastState(number rpc.BlockNumber) (*types.Header, *state.StateDB, error) {
+	chain := s.b.Blockchain()
+	if number < 0 || uint64(number) == chain.CurrentHeader().Number.Uint64() {
+		return nil, nil, nil
+	}
+
+	header := chain.GetHeaderByNumber(uint64(number))
+	if header == nil {
+		return nil, nil, errors.Errorf("block(=%d) not found", number)
+	}
+	stateDB, err := chain.StateAt(header.Root)
+	return header, stateDB, err
+}
+
+func (s *simulatedService) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := s.b.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+func (s *simulatedService) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tip, err := s.b.SuggestGasTipCap(ctx)
+	return (*hexutil.Big)(tip), err
+}
+
+func (s *simulatedService) GetBalance(ctx context.Context, account common.Address, number rpc.BlockNumber) (*hexutil.Big, error) {
+	header, stateDB, err := s.pastState(number)
+	if err != nil {
+		return nil, err
+	}
+	if header != nil {
+		return (*hexutil.Big)(stateDB.GetBalance(account)), nil
+	}
+
+	balance, err := s.b.BalanceAt(ctx, account, nil)
+	return (*hexutil.Big)(balance), err
+}
+
+func (s *simulatedService) GetCode(ctx context.Context, account common.Address, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	header, stateDB, err := s.pastState(number)
+	if err != nil {
+		return nil, err
+	}
+	if header != nil {
+		return stateDB.GetCode(account), nil
+	}
+	return s.b.CodeAt(ctx, account, nil)
+}
+
+func (s *simulatedService) GetTransactionCount(ctx context.Context, account common.Address, number rpc.BlockNumber) (hexutil.Uint64, error) {
+	var (
+		nonce uint64
+		err   error
+	)
+	if number == rpc.PendingBlockNumber {
+		nonce, err = s.b.PendingNonceAt(ctx, account)
+	} else {
+		nonce, err = s.b.NonceAt(ctx, account, blockNumber(number))
+	}
+	return hexutil.Uint64(nonce), err
+}
+
+func (s *simulatedService) Call(ctx context.Context, args callArgs, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	header, stateDB, err := s.pastState(number)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return s.b.CallContract(ctx, args.msg(), nil)
+	}
+
+	// replays the call on top of the past state, like eth_call of a full node
+	var (
+		chain = s.b.Blockchain()
+		call  = args.msg()
+		value = new(big.Int)
+		price = new(big.Int)
+		gas   = header.GasLimit
+	)
+	if call.Value != nil {
+		value = call.Value
+	}
+	if call.Gas != 0 {
+		gas = call.Gas
+	}
+	msg := types.NewMessage(call.From, call.To, stateDB.GetNonce(call.From), value, gas, price, price, price, call.Data, nil, true)
+	evm := vm.NewEVM(core.NewEVMBlockContext(header, chain, nil), core.NewEVMTxContext(msg), stateDB, chain.Config(), vm.Config{NoBaseFee: true})
+
+	result, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64))
+	if err != nil {
+		return nil, err
+	}
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	return result.Return(), nil
+}
+
+func (s *simulatedService) EstimateGas(ctx context.Context, args callArgs) (hexutil.Uint64, error) {
+	gas, err := s.b.EstimateGas(ctx, args.msg())
+	return hexutil.Uint64(gas), err
+}
+
+func (s *simulatedService) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	if err := s.b.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	s.b.Commit()
+	return tx.Hash(), nil
+}
+
+func (s *simulatedService) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipt, err := s.b.TransactionReceipt(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	}
+	return receipt, err
+}
+
+func (s *simulatedService) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]types.Log, error) {
+	logs, err := s.b.FilterLogs(ctx, ethereum.FilterQuery(crit))
+	if logs == nil {
+		logs = []types.Log{}
+	}
+	return logs, err
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ango-ya/chain-client/contract"
+	"github.com/ango-ya/chain-client/data"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// GetTokenInfo reads the state of a token and of its compliance service.
+// Every value is read at the same block, the latest one when the query starts unless req.Block is set.
+// The pending block is rejected, as it may change between the reads of the token and of its compliance service.
+func (c *BlockchainClient) GetTokenInfo(ctx context.Context, req data.TokenInfoRequest) (resp data.TokenInfoResponse, err error) {
+	ctx, call := c.begin(ctx, "GetTokenInfo", data.RequestType_TOKEN_INFO, &req)
+	defer c.end(call, &err)
+
+	if err = req.Validate(c.validateOpts...); err != nil {
+		err = invalidRequest(err)
+		return
+	}
+
+	block, err := c.blockAt(ctx, req.GetBlock())
+	if err != nil {
+		return
+	}
+
+	// the latest block is pinned by number, so that the token and its compliance service are read at the same block
+	if block == nil {
+		header, err := c.backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return resp, errors.Wrap(err, "failed to get the header of the block to read")
+		}
+		block = header.Number
+	}
+
+	var (
+		contractAddress = common.HexToAddress(req.GetContractAddress())
+		name, symbol    string
+		decimals        uint8
+		version         uint16
+		supply, docs    *big.Int
+		compliance      common.Address
+	)
+	err = c.queryAt(ctx, c.stABI, contractAddress, block, []string{"name", "symbol", "decimals", "totalSupply", "nowCompliance", "complianceVersion", "countDocument"},
+		&name, &symbol, &decimals, &supply, &compliance, &version, &docs)
+	if err != nil {
+		return
+	}
+	c.decimals.set(contractAddress, decimals)
+
+	var (
+		paused, transferPaused bool
+		wallets                *big.Int
+	)
+	err = c.queryAt(ctx, c.csABI, compliance, block, []string{"paused", "transferPaused", "countWallet"}, &paused, &transferPaused, &wallets)
+	if err != nil {
+		return
+	}
+
+	resp = data.TokenInfoResponse{
+		Name:                 name,
+		Symbol:               symbol,
+		Decimals:             uint32(decimals),
+		TotalSupply:          supply.String(),
+		FormattedTotalSupply: data.FromWei(supply, int(decimals)),
+		ComplianceAddress:    compliance.String(),
+		ComplianceVersion:    uint32(version),
+		DocumentCount:        docs.Uint64(),
+		Paused:               paused,
+		TransferPaused:       transferPaused,
+		WalletCount:          wallets.Uint64(),
+		BlockNumber:          block.Uint64(),
+	}
+	return
+}
+
+// queryAt calls the argument-less methods of a contract in a single batch at block, unpacking the output of each into outs.
+func (c *BlockchainClient) queryAt(ctx context.Context, contractABI *contract.ParsedABI, contractAddress common.Address, block *big.Int, methods []string, outs ...interface{}) error {
+	calls := make([]Call, len(methods))
+	for i, method := range methods {
+		input, err := pack(&contractABI.ABI, method)
+		if err != nil {
+			return err
+		}
+		calls[i] = Call{To: contractAddress, Input: input}
+	}
+
+	results, err := c.BatchCall(ctx, calls, block)
+	if err != nil {
+		return err
+	}
+
+	for i, r := range results {
+		if r.Err != nil {
+			return errors.Wrapf(r.Err, "failed to query %s of contract(=%s) at block(=%s)", methods[i], contractAddress.String(), block)
+		}
+
+		if err := unpack(&contractABI.ABI, methods[i], r.Output, outs[i]); err != nil {
+			return errors.Wrapf(err, "contract(=%s)", contractAddress.String())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	TracerName = "github.com/ango-ya/chain-client/client"
+
+	OutcomeOK   = "ok"
+	OutcomeSent = "sent"
+)
+
+var (
+	AttrRequestType = attribute.Key("chain.request_type")
+	AttrContract    = attribute.Key("chain.contract")
+	AttrTxHash      = attribute.Key("chain.tx_hash")
+	AttrAsync       = attribute.Key("chain.async")
+	AttrOutcome     = attribute.Key("chain.outcome")
+	AttrBlockNumber = attribute.Key("chain.block_number")
+	AttrGasUsed     = attribute.Key("chain.gas_used")
+)
+
+// endSpan records the outcome of the span and ends it, outcome is the error category on failure.
+func endSpan(span trace.Span, outcome string, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		outcome = ErrorCategory(err)
+	}
+	span.SetAttributes(AttrOutcome.String(outcome))
+	span.End()
+}
+
+// traceTx reports the outcome of an async transaction as a span linked to the request which sent it.
+func traceTx(tracer trace.Tracer) TxResultHandler {
+	return func(r TxResult) {
+		_, span := tracer.Start(context.Background(), "BlockchainClient.confirm",
+			trace.WithTimestamp(r.SentAt),
+			trace.WithLinks(trace.Link{SpanContext: r.link}),
+			trace.WithAttributes(
+				attribute.String("chain.method", r.Method),
+				AttrTxHash.String(r.Hash),
+				AttrBlockNumber.Int64(int64(r.BlockNumber)),
+				AttrGasUsed.Int64(int64(r.GasUsed)),
+			))
+
+		span.SetAttributes(AttrOutcome.String(string(r.Status)))
+		if r.Err != nil {
+			span.RecordError(r.Err)
+			span.SetStatus(codes.Error, r.Err.Error())
+		}
+		span.End(trace.WithTimestamp(r.DoneAt))
+	}
+}
+
+// tracedBackend wraps every RPC of a ChainBackend into a span, child of the span of the BlockchainClient method.
+type tracedBackend struct {
+	ChainBackend
+	tracer trace.Tracer
+}
+
+var _ ChainBackend = (*tracedBackend)(nil)
+
+// untraced returns the backend the client was built with, so that background polling is not traced.
+func untraced(b ChainBackend) ChainBackend {
+	if t, ok := b.(*tracedBackend); ok {
+		return t.ChainBackend
+	}
+	return b
+}
+
+func (b *tracedBackend) start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return b.tracer.Start(ctx, "ChainBackend."+name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+func contractAttr(to *common.Address) []attribute.KeyValue {
+	if to == nil {
+		return nil
+	}
+	return []attribute.KeyValue{AttrContract.String(to.String())}
+}
+
+func blockAttr(block *big.Int) attribute.KeyValue {
+	if block == nil || block.Cmp(PendingBlock) == 0 {
+		return AttrBlockNumber.String(toBlockNumArg(block))
+	}
+	return AttrBlockNumber.String(block.String())
+}
+
+func (b *tracedBackend) SyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (hash string, err error) {
+	ctx, span := b.start(ctx, "SyncSend", contractAttr(to)...)
+	defer func() {
+		span.SetAttributes(AttrTxHash.String(hash))
+		endSpan(span, OutcomeOK, err)
+	}()
+
+	return b.ChainBackend.SyncSend(ctx, priv, to, amount, input, gasLimit)
+}
+
+func (b *tracedBackend) AsyncSend(ctx context.Context, priv string, to *common.Address, amount *big.Int, input []byte, gasLimit uint64) (hash string, err error) {
+	ctx, span := b.start(ctx, "AsyncSend", contractAttr(to)...)
+	defer func() {
+		span.SetAttributes(AttrTxHash.String(hash))
+		endSpan(span, OutcomeSent, err)
+	}()
+
+	return b.ChainBackend.AsyncSend(ctx, priv, to, amount, input, gasLimit)
+}
+
+func (b *tracedBackend) EnqueueTxHash(ctx context.Context, hash string) (err error) {
+	ctx, span := b.start(ctx, "EnqueueTxHash", AttrTxHash.String(hash))
+	defer func() { endSpan(span, OutcomeOK, err) }()
+
+	return b.ChainBackend.EnqueueTxHash(ctx, hash)
+}
+
+func (b *tracedBackend) Receipt(ctx context.Context, hash string) (r *types.Receipt, err error) {
+	ctx, span := b.start(ctx, "Receipt", AttrTxHash.String(hash))
+	defer func() { endSpan(span, OutcomeOK, err) }()
+
+	return b.ChainBackend.Receipt(ctx, hash)
+}
+
+func (b *tracedBackend) CallContract(ctx context.Context, to common.Address, input []byte, block *big.Int) (output []byte, err error) {
+	ctx, span := b.start(ctx, "CallContract", AttrContract.String(to.String()), blockAttr(block))
+	defer func() { endSpan(span, OutcomeOK, err) }()
+
+	return b.ChainBackend.CallContract(ctx, to, input, block)
+}
+
+func (b *tracedBackend) BatchCallContract(ctx context.Context, calls []Call, block *big.Int) (results []CallResult, err error) {
+	ctx, span := b.start(ctx, "BatchCallContract", attribute.Int("chain.calls", len(calls)), blockAttr(block))
+	defer func() { endSpan(span, OutcomeOK, err) }()
+
+	return batchCallContract(ctx, b.ChainBackend, calls, block)
+}
+
+func (b *tracedBackend) BalanceAt(ctx context.Context, account common.Address, block *big.Int) (balance *big.Int, err error) {
+	ctx, span := b.start(ctx, "BalanceAt", blockAttr(block))
+	defer func() { endSpan(span, OutcomeOK, err) }()
+
+	return b.ChainBackend.BalanceAt(ctx, account, block)
+}
+
+func (b *tracedBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	ctx, span := b.start(ctx, "FilterLogs")
+	defer func() { endSpan(span, OutcomeOK, err) }()
+
+	return b.ChainBackend.FilterLogs(ctx, query)
+}
+
+func (b *tracedBackend) HeaderByTag(ctx context.Context, tag string) (header *types.Header, err error) {
+	ctx, span := b.start(ctx, "HeaderByTag", AttrBlockNumber.String(tag))
+	defer func() { endSpan(span, OutcomeOK, err) }()
+
+	return headerByTag(ctx, b.ChainBackend, tag)
+}
+
+func (b *tracedBackend) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	ctx, span := b.start(ctx, "HeaderByNumber", blockAttr(number))
+	defer func() { endSpan(span, OutcomeOK, err) }()
+
+	return b.ChainBackend.HeaderByNumber(ctx, number)
+}
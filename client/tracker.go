@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	DefaultTrackInterval = int64(500) // 500 ms
+)
+
+type TxStatus string
+
+const (
+	TxConfirmed TxStatus = "confirmed"
+	TxReverted  TxStatus = "reverted"
+	TxDropped   TxStatus = "dropped"
+)
+
+// TxResult is the outcome of a transaction sent asynchronously.
+type TxResult struct {
+	Hash        string
+	Method      string
+	Status      TxStatus
+	BlockNumber uint64
+	GasUsed     uint64
+	SentAt      time.Time
+	DoneAt      time.Time
+	Err         error
+
+	// the span of the request which sent the transaction
+	link trace.SpanContext
+}
+
+type TxResultHandler func(TxResult)
+
+type pendingTx struct {
+	method string
+	sentAt time.Time
+	link   trace.SpanContext
+}
+
+// txTracker follows async transactions until they are mined or time out, and hands the outcome to the handlers.
+type txTracker struct {
+	sync.Mutex
+
+	pending  map[string]pendingTx
+	handlers []TxResultHandler
+	interval time.Duration
+	timeout  time.Duration
+
+	// rpcTimeout bounds each receipt lookup
+	rpcTimeout time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newTxTracker() *txTracker {
+	return &txTracker{
+		pending:  make(map[string]pendingTx),
+		interval: time.Duration(DefaultTrackInterval) * time.Millisecond,
+	}
+}
+
+func (t *txTracker) enabled() bool {
+	return len(t.handlers) > 0
+}
+
+func (t *txTracker) add(hash, method string, link trace.SpanContext) {
+	if !t.enabled() {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	t.pending[hash] = pendingTx{method: method, sentAt: time.Now(), link: link}
+}
+
+func (t *txTracker) start(receipt func(context.Context, string) (*types.Receipt, error)) {
+	if !t.enabled() {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	t.done = make(chan struct{})
+
+	go func() {
+		defer close(t.done)
+
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				t.poll(ctx, receipt)
+			}
+		}
+	}()
+}
+
+func (t *txTracker) stop() {
+	if t.cancel == nil {
+		return
+	}
+
+	t.cancel()
+	<-t.done
+}
+
+func (t *txTracker) poll(ctx context.Context, receipt func(context.Context, string) (*types.Receipt, error)) {
+	t.Lock()
+	txs := make(map[string]pendingTx, len(t.pending))
+	for hash, tx := range t.pending {
+		txs[hash] = tx
+	}
+	t.Unlock()
+
+	for hash, tx := range txs {
+		result := TxResult{Hash: hash, Method: tx.method, SentAt: tx.sentAt, link: tx.link}
+
+		rctx, cancel := context.WithTimeout(ctx, t.rpcTimeout)
+		r, err := receipt(rctx, hash)
+		cancel()
+		switch {
+		case err == nil:
+			result.BlockNumber = r.BlockNumber.Uint64()
+			result.GasUsed = r.GasUsed
+			if r.Status == types.ReceiptStatusSuccessful {
+				result.Status = TxConfirmed
+			} else {
+				result.Status = TxReverted
+				result.Err = errors.Wrapf(ErrTxReverted, "status=%d, block=%d", r.Status, r.BlockNumber)
+			}
+		case ctx.Err() != nil:
+			return
+		case time.Since(tx.sentAt) < t.timeout:
+			// not mined yet or the node is lagging, retry on the next tick
+			continue
+		default:
+			result.Status = TxDropped
+			result.Err = errors.Wrapf(err, "not mined within %s", t.timeout)
+		}
+
+		t.Lock()
+		delete(t.pending, hash)
+		t.Unlock()
+
+		result.DoneAt = time.Now()
+		for _, h := range t.handlers {
+			h(result)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package contract
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ParsedABI is a contract ABI parsed once and shared, with the selectors of its methods and the topics of its events.
+type ParsedABI struct {
+	abi.ABI
+
+	Selectors map[string][4]byte
+	Topics    map[string]common.Hash
+
+	methods map[[4]byte]*abi.Method
+	events  map[common.Hash]*abi.Event
+}
+
+func newParsedABI(source string) (*ParsedABI, error) {
+	parsed, err := abi.JSON(strings.NewReader(source))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ParsedABI{
+		ABI:       parsed,
+		Selectors: make(map[string][4]byte, len(parsed.Methods)),
+		Topics:    make(map[string]common.Hash, len(parsed.Events)),
+		methods:   make(map[[4]byte]*abi.Method, len(parsed.Methods)),
+		events:    make(map[common.Hash]*abi.Event, len(parsed.Events)),
+	}
+	for name := range parsed.Methods {
+		m := parsed.Methods[name]
+		var id [4]byte
+		copy(id[:], m.ID)
+		p.Selectors[name] = id
+		p.methods[id] = &m
+	}
+	for name := range parsed.Events {
+		e := parsed.Events[name]
+		p.Topics[name] = e.ID
+		p.events[e.ID] = &e
+	}
+	return p, nil
+}
+
+// MethodByID looks up the method called by input, without the linear search of abi.ABI.MethodById.
+func (p *ParsedABI) MethodByID(input []byte) (*abi.Method, error) {
+	if len(input) < 4 {
+		return nil, errors.Errorf("data too short (%d bytes) for abi method lookup", len(input))
+	}
+
+	var id [4]byte
+	copy(id[:], input[:4])
+	m, ok := p.methods[id]
+	if !ok {
+		return nil, errors.Errorf("no method with id: %#x", id)
+	}
+	return m, nil
+}
+
+// EventByID looks up the event of a log topic, without the linear search of abi.ABI.EventByID.
+func (p *ParsedABI) EventByID(topic common.Hash) (*abi.Event, error) {
+	e, ok := p.events[topic]
+	if !ok {
+		return nil, errors.Errorf("no event with id: %s", topic.Hex())
+	}
+	return e, nil
+}
+
+// lazyABI parses its source on first use.
+type lazyABI struct {
+	once   sync.Once
+	source string
+	parsed *ParsedABI
+	err    error
+}
+
+func (l *lazyABI) get() (*ParsedABI, error) {
+	l.once.Do(func() {
+		l.parsed, l.err = newParsedABI(l.source)
+	})
+	return l.parsed, l.err
+}
+
+var (
+	securityTokenABI     = &lazyABI{source: SecurityTokenABI}
+	complianceServiceABI = &lazyABI{source: ComplianceServiceABI}
+	factoryV0ABI         = &lazyABI{source: FactoryV0ABI}
+)
+
+// ParsedSecurityTokenABI is SecurityTokenABI, parsed once for the whole process.
+func ParsedSecurityTokenABI() (*ParsedABI, error) {
+	return securityTokenABI.get()
+}
+
+// ParsedComplianceServiceABI is ComplianceServiceABI, parsed once for the whole process.
+func ParsedComplianceServiceABI() (*ParsedABI, error) {
+	return complianceServiceABI.get()
+}
+
+// ParsedFactoryV0ABI is FactoryV0ABI, parsed once for the whole process.
+func ParsedFactoryV0ABI() (*ParsedABI, error) {
+	return factoryV0ABI.get()
+}
+
+// BindSecurityToken is NewSecurityToken on the shared parsed ABI.
+func BindSecurityToken(address common.Address, backend bind.ContractBackend) (*SecurityToken, error) {
+	parsed, err := ParsedSecurityTokenABI()
+	if err != nil {
+		return nil, err
+	}
+
+	contract := bind.NewBoundContract(address, parsed.ABI, backend, backend, backend)
+	return &SecurityToken{SecurityTokenCaller: SecurityTokenCaller{contract: contract}, SecurityTokenTransactor: SecurityTokenTransactor{contract: contract}, SecurityTokenFilterer: SecurityTokenFilterer{contract: contract}}, nil
+}
+
+// BindComplianceService is NewComplianceService on the shared parsed ABI.
+func BindComplianceService(address common.Address, backend bind.ContractBackend) (*ComplianceService, error) {
+	parsed, err := ParsedComplianceServiceABI()
+	if err != nil {
+		return nil, err
+	}
+
+	contract := bind.NewBoundContract(address, parsed.ABI, backend, backend, backend)
+	return &ComplianceService{ComplianceServiceCaller: ComplianceServiceCaller{contract: contract}, ComplianceServiceTransactor: ComplianceServiceTransactor{contract: contract}, ComplianceServiceFilterer: ComplianceServiceFilterer{contract: contract}}, nil
+}
+
+// BindFactoryV0 is NewFactoryV0 on the shared parsed ABI.
+func BindFactoryV0(address common.Address, backend bind.ContractBackend) (*FactoryV0, error) {
+	parsed, err := ParsedFactoryV0ABI()
+	if err != nil {
+		return nil, err
+	}
+
+	contract := bind.NewBoundContract(address, parsed.ABI, backend, backend, backend)
+	return &FactoryV0{FactoryV0Caller: FactoryV0Caller{contract: contract}, FactoryV0Transactor: FactoryV0Transactor{contract: contract}, FactoryV0Filterer: FactoryV0Filterer{contract: contract}}, nil
+}
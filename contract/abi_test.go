@@ -0,0 +1,100 @@
+package contract
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsedABI(t *testing.T) {
+	var (
+		wg     sync.WaitGroup
+		parsed = make([]*ParsedABI, 8)
+	)
+	// 並行に呼ばれても一度だけ解析される
+	for i := range parsed {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			parsed[i], _ = ParsedSecurityTokenABI()
+		}(i)
+	}
+	wg.Wait()
+	for _, p := range parsed {
+		require.Same(t, parsed[0], p)
+	}
+
+	for _, get := range []func() (*ParsedABI, error){ParsedSecurityTokenABI, ParsedComplianceServiceABI, ParsedFactoryV0ABI} {
+		p, err := get()
+		require.NoError(t, err)
+
+		for name, m := range p.Methods {
+			selector := p.Selectors[name]
+			require.Equal(t, m.ID, selector[:], name)
+
+			found, err := p.MethodByID(append(m.ID, 0x01, 0x02))
+			require.NoError(t, err)
+			require.Equal(t, name, found.Name)
+		}
+		for name, e := range p.Events {
+			require.Equal(t, e.ID, p.Topics[name], name)
+
+			found, err := p.EventByID(e.ID)
+			require.NoError(t, err)
+			require.Equal(t, name, found.Name)
+		}
+	}
+
+	st, _ := ParsedSecurityTokenABI()
+	_, err := st.MethodByID([]byte{0x01, 0x02})
+	require.Error(t, err)
+	_, err = st.MethodByID([]byte{0xde, 0xad, 0xbe, 0xef})
+	require.Error(t, err)
+}
+
+func BenchmarkParseABI(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, source := range []string{SecurityTokenABI, ComplianceServiceABI, FactoryV0ABI} {
+			if _, err := abi.JSON(strings.NewReader(source)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkParsedABI(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, get := range []func() (*ParsedABI, error){ParsedSecurityTokenABI, ParsedComplianceServiceABI, ParsedFactoryV0ABI} {
+			if _, err := get(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkMethodById(b *testing.B) {
+	p, _ := ParsedComplianceServiceABI()
+	input := p.Methods["validateUpdating"].ID
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.ABI.MethodById(input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMethodByID(b *testing.B) {
+	p, _ := ParsedComplianceServiceABI()
+	input := p.Methods["validateUpdating"].ID
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.MethodByID(input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
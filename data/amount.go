@@ -0,0 +1,103 @@
+package data
+
+import (
+	"math"
+	"math/big"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	EtherDecimals = 18
+
+	// DefaultDecimals is used for tokens not deployed yet, SecurityToken keeps the ERC20 default
+	DefaultDecimals = 18
+)
+
+var (
+	ErrInvalidAmount         = errors.New("invalid amount")
+	ErrNegativeAmount        = errors.New("negative amount")
+	ErrTooManyDecimals       = errors.New("too many fractional digits")
+	ErrUnsupportedAmountType = errors.New("unsupported amount type")
+
+	amountPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+)
+
+// Amount is a non negative amount in human units, e.g. "1.5" tokens, which is converted exactly into base units.
+type Amount struct {
+	d decimal.Decimal
+}
+
+// ParseAmount accepts plain decimal strings such as "100" or "0.25", integers, finite floats, *big.Int and decimals.
+// Signs, exponents and blanks are rejected in strings.
+func ParseAmount(iamount interface{}) (a Amount, err error) {
+	switch v := iamount.(type) {
+	case string:
+		if !amountPattern.MatchString(v) {
+			return a, errors.Wrapf(ErrInvalidAmount, "%q", v)
+		}
+		if a.d, err = decimal.NewFromString(v); err != nil {
+			return a, errors.Wrapf(ErrInvalidAmount, "%q: %s", v, err)
+		}
+	case int:
+		a.d = decimal.NewFromInt(int64(v))
+	case int64:
+		a.d = decimal.NewFromInt(v)
+	case uint64:
+		a.d = decimal.NewFromBigInt(new(big.Int).SetUint64(v), 0)
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return a, errors.Wrapf(ErrInvalidAmount, "%v", v)
+		}
+		a.d = decimal.NewFromFloat(v)
+	case *big.Int:
+		if v == nil {
+			return a, errors.Wrap(ErrInvalidAmount, "nil")
+		}
+		a.d = decimal.NewFromBigInt(v, 0)
+	case decimal.Decimal:
+		a.d = v
+	case *decimal.Decimal:
+		if v == nil {
+			return a, errors.Wrap(ErrInvalidAmount, "nil")
+		}
+		a.d = *v
+	case Amount:
+		a = v
+	default:
+		return a, errors.Wrapf(ErrUnsupportedAmountType, "%T", iamount)
+	}
+
+	if a.d.Sign() < 0 {
+		return Amount{}, errors.Wrapf(ErrNegativeAmount, "%s", a.d)
+	}
+	return
+}
+
+// AmountFromWei is the amount of base units with the given decimals.
+func AmountFromWei(wei *big.Int, decimals int) Amount {
+	return Amount{decimal.NewFromBigInt(wei, -int32(decimals))}
+}
+
+// Wei converts the amount into base units, failing rather than truncating when it is more precise than decimals.
+func (a Amount) Wei(decimals int) (*big.Int, error) {
+	if decimals < 0 {
+		return nil, errors.Errorf("negative decimals(=%d)", decimals)
+	}
+
+	shifted := a.d.Shift(int32(decimals))
+	if !shifted.IsInteger() {
+		return nil, errors.Wrapf(ErrTooManyDecimals, "%s with %d decimals", a.d, decimals)
+	}
+	return shifted.BigInt(), nil
+}
+
+func (a Amount) String() string {
+	return a.d.String()
+}
+
+func (a Amount) IsZero() bool {
+	return a.d.IsZero()
+}
@@ -0,0 +1,134 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+	"testing/quick"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	valid := map[interface{}]string{
+		"100":                  "100",
+		"0.25":                 "0.25",
+		"1.50":                 "1.5",
+		"0":                    "0",
+		int(7):                 "7",
+		int64(math.MaxInt64):   "9223372036854775807",
+		uint64(math.MaxUint64): "18446744073709551615",
+		0.5:                    "0.5",
+		big.NewInt(42):         "42",
+		decimal.New(15, -1):    "1.5",
+	}
+	for in, expected := range valid {
+		a, err := ParseAmount(in)
+		require.NoError(t, err, in)
+		require.Equal(t, expected, a.String(), in)
+	}
+
+	invalid := map[interface{}]error{
+		"":                 ErrInvalidAmount,
+		" 1":               ErrInvalidAmount,
+		"1e18":             ErrInvalidAmount,
+		"+1":               ErrInvalidAmount,
+		"-1":               ErrInvalidAmount,
+		"1.":               ErrInvalidAmount,
+		".5":               ErrInvalidAmount,
+		"0x10":             ErrInvalidAmount,
+		int64(-1):          ErrNegativeAmount,
+		-0.1:               ErrNegativeAmount,
+		decimal.New(-1, 0): ErrNegativeAmount,
+		math.NaN():         ErrInvalidAmount,
+		math.Inf(1):        ErrInvalidAmount,
+		(*big.Int)(nil):    ErrInvalidAmount,
+		int32(1):           ErrUnsupportedAmountType,
+		nil:                ErrUnsupportedAmountType,
+	}
+	for in, expected := range invalid {
+		_, err := ParseAmount(in)
+		require.ErrorIs(t, err, expected, "%#v", in)
+	}
+}
+
+func TestAmountWei(t *testing.T) {
+	a, _ := ParseAmount("1.5")
+	wei, err := a.Wei(18)
+	require.NoError(t, err)
+	require.Equal(t, "1500000000000000000", wei.String())
+
+	// 桁数を超える小数は切り捨てずにエラーとする
+	_, err = a.Wei(0)
+	require.ErrorIs(t, err, ErrTooManyDecimals)
+
+	a, _ = ParseAmount("0.0000000000000000001")
+	_, err = a.Wei(18)
+	require.ErrorIs(t, err, ErrTooManyDecimals)
+
+	// int64はfloat64を経由しない
+	wei, err = ToWei(int64(math.MaxInt64), 0)
+	require.NoError(t, err)
+	require.Equal(t, "9223372036854775807", wei.String())
+
+	_, err = ToWei("-1", 18)
+	require.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+// ToWei と FromWei は互いに逆変換になる
+func TestAmountRoundTrip(t *testing.T) {
+	property := func(units uint64, decimals uint8) bool {
+		d := int(decimals % 40)
+		wei := new(big.Int).SetUint64(units)
+
+		amount := FromWei(wei, d)
+		back, err := ToWei(amount, d)
+		return err == nil && back.Cmp(wei) == 0
+	}
+	require.NoError(t, quick.Check(property, nil))
+}
+
+// 形式が正しい文字列は受け付けられ、それ以外は拒否される
+func TestParseAmountStrings(t *testing.T) {
+	property := func(s string) bool {
+		_, err := ParseAmount(s)
+		return (err == nil) == amountPattern.MatchString(s)
+	}
+	require.NoError(t, quick.Check(property, nil))
+
+}
+
+func TestParseAmountProperties(t *testing.T) {
+	// 解析できた値は桁数の範囲内で往復しても変わらない
+	check := func(s string, decimals uint8) bool {
+		a, err := ParseAmount(s)
+		if err != nil {
+			return true
+		}
+		if !amountPattern.MatchString(s) {
+			return false
+		}
+
+		wei, err := a.Wei(int(decimals))
+		if err != nil {
+			return errors.Is(err, ErrTooManyDecimals)
+		}
+		if wei.Sign() < 0 {
+			return false
+		}
+
+		back, err := ToWei(FromWei(wei, int(decimals)), int(decimals))
+		return err == nil && back.Cmp(wei) == 0
+	}
+
+	for _, seed := range []string{"0", "1", "1.5", "-1", "1e3", "0.000000000000000001", "", "abc"} {
+		require.True(t, check(seed, 18), seed)
+	}
+	require.NoError(t, quick.Check(check, nil))
+	require.NoError(t, quick.Check(func(whole, frac uint64, decimals uint8) bool {
+		return check(fmt.Sprintf("%d.%d", whole, frac), decimals)
+	}, nil))
+}
@@ -0,0 +1,47 @@
+package data
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// the block tags accepted in the block field of the read requests
+const (
+	BlockLatest    = "latest"
+	BlockPending   = "pending"
+	BlockSafe      = "safe"
+	BlockFinalized = "finalized"
+	BlockEarliest  = "earliest"
+)
+
+var (
+	ErrInvalidBlock = errors.New("invalid block, expected a number or one of latest, pending, safe, finalized, earliest")
+	ErrPendingBlock = errors.New("the pending block is not supported by this request")
+)
+
+// ParseBlock reads the block of a read request, either a tag or a decimal or hex block number.
+// An empty block is the latest one. Exactly one of number and tag is set.
+func ParseBlock(block string) (number *big.Int, tag string, err error) {
+	switch block {
+	case "":
+		return nil, BlockLatest, nil
+	case BlockLatest, BlockPending, BlockSafe, BlockFinalized, BlockEarliest:
+		return nil, block, nil
+	}
+
+	if strings.HasPrefix(block, "0x") {
+		if number, err = hexutil.DecodeBig(block); err != nil {
+			return nil, "", errors.Wrapf(ErrInvalidBlock, "%q", block)
+		}
+		return number, "", nil
+	}
+
+	number, ok := new(big.Int).SetString(block, 10)
+	if !ok || number.Sign() < 0 {
+		return nil, "", errors.Wrapf(ErrInvalidBlock, "%q", block)
+	}
+	return number, "", nil
+}
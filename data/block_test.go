@@ -0,0 +1,44 @@
+package data
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlock(t *testing.T) {
+	for _, c := range []struct {
+		block  string
+		number *big.Int
+		tag    string
+	}{
+		{"", nil, BlockLatest},
+		{"latest", nil, BlockLatest},
+		{"pending", nil, BlockPending},
+		{"safe", nil, BlockSafe},
+		{"finalized", nil, BlockFinalized},
+		{"earliest", nil, BlockEarliest},
+		{"123", big.NewInt(123), ""},
+		{"0x7b", big.NewInt(123), ""},
+		{"0", big.NewInt(0), ""},
+	} {
+		number, tag, err := ParseBlock(c.block)
+		require.NoError(t, err, c.block)
+		require.Equal(t, c.number, number, c.block)
+		require.Equal(t, c.tag, tag, c.block)
+	}
+
+	for _, invalid := range []string{"-1", "1.5", "0x", "0xzz", "Latest", " 1", "head"} {
+		_, _, err := ParseBlock(invalid)
+		require.ErrorIs(t, err, ErrInvalidBlock, invalid)
+	}
+
+	// 不正なブロックはフィールドエラーになる
+	err := (&BalanceOfRequest{ContractAddress: TestContract, Account: TestChecksummed, Block: "head"}).Validate()
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	f, ok := verr.Field("block")
+	require.True(t, ok)
+	require.Equal(t, CodeInvalidBlock, f.Code)
+}
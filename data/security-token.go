@@ -1,12 +1,10 @@
 package data
 
 import (
-	"encoding/hex"
+	"fmt"
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
-	"github.com/shopspring/decimal"
 )
 
 const (
@@ -14,189 +12,217 @@ const (
 	ST_EDIT_ROLE    = "025c10ffb4b4f977a8899da54e53278bc52863e80645c6b1f1ee5085ab0069bc"
 )
 
-func (r *SendETHRequest) Validate() error {
-	if err := validateAddress(r.GetRecipient()); err != nil {
-		return errors.Wrap(err, "invalid recipient")
-	}
-	if _, err := ToWei(r.GetAmount(), 18); err != nil {
-		return errors.Wrapf(err, "invalid amount(=%v)", r.GetAmount())
-	}
-	return nil
+func (r *SendETHRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.privateKey("private_key", r.GetPrivateKey())
+	v.address("recipient", r.GetRecipient())
+	v.amountWithDecimals("amount", r.GetAmount(), EtherDecimals)
+	return v.err()
 }
 
-func (r *BalanceOfETHRequest) Validate() error {
-	if err := validateAddress(r.GetAccount()); err != nil {
-		return errors.Wrap(err, "invalid account")
-	}
-	return nil
+func (r *BalanceOfETHRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.address("account", r.GetAccount())
+	v.block("block", r.GetBlock())
+	return v.err()
 }
 
-func (r *DeploySTRequest) Validate() error {
-	if err := validateAddress(r.GetComplianceAddress()); err != nil {
-		return errors.Wrap(err, "invalid compliance address")
-	}
-	if _, err := ToWei(r.GetInitialSupply(), 18); err != nil {
-		return errors.Wrapf(err, "invalid inital supply(=%v)", r.GetInitialSupply())
-	}
-	return nil
+func (r *DeploySTRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.privateKey("private_key", r.GetPrivateKey())
+	v.required("name", r.GetName())
+	v.required("symbol", r.GetSymbol())
+	v.amountWithDecimals("initialSupply", r.GetInitialSupply(), DefaultDecimals)
+	v.address("compliance_address", r.GetComplianceAddress())
+	return v.err()
 }
 
-func (r *IssueRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	if err := validateAddress(r.GetRecipient()); err != nil {
-		return errors.Wrap(err, "invalid recipient address")
-	}
-	if _, err := ToWei(r.GetAmount(), 18); err != nil {
-		return errors.Wrapf(err, "invalid amount(=%v)", r.GetAmount())
-	}
-	return nil
+func (r *IssueRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.privateKey("private_key", r.GetPrivateKey())
+	v.address("contract_address", r.GetContractAddress())
+	v.recipient("recipient", r.GetRecipient(), r.GetContractAddress())
+	v.amount("amount", r.GetAmount())
+	return v.err()
 }
 
-func (r *TransferRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	if err := validateAddress(r.GetRecipient()); err != nil {
-		return errors.Wrap(err, "invalid recipient address")
-	}
-	if _, err := ToWei(r.GetAmount(), 18); err != nil {
-		return errors.Wrapf(err, "invalid amount(=%v)", r.GetAmount())
-	}
-	return nil
+func (r *RedeemRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.privateKey("private_key", r.GetPrivateKey())
+	v.address("contract_address", r.GetContractAddress())
+	v.address("account", r.GetAccount())
+	v.amount("amount", r.GetAmount())
+	return v.err()
 }
 
-func (r *RedeemRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	if err := validateAddress(r.GetAccount()); err != nil {
-		return errors.Wrap(err, "invalid account address")
-	}
-	if _, err := ToWei(r.GetAmount(), 18); err != nil {
-		return errors.Wrapf(err, "invalid amount(=%v)", r.GetAmount())
-	}
-	return nil
+func (r *TransferRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.privateKey("private_key", r.GetPrivateKey())
+	v.address("contract_address", r.GetContractAddress())
+	v.recipient("recipient", r.GetRecipient(), r.GetContractAddress())
+	v.amount("amount", r.GetAmount())
+	return v.err()
 }
 
-func (r *RegisterWalletRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	if err := validateAddress(r.GetAccount()); err != nil {
-		return errors.Wrap(err, "invalid account address")
-	}
-	return nil
+func (r *RegisterWalletRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.privateKey("private_key", r.GetPrivateKey())
+	v.address("contract_address", r.GetContractAddress())
+	v.address("account", r.GetAccount())
+	return v.err()
 }
 
-func (r *GrantRoleRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	if err := validateAddress(r.GetGrantee()); err != nil {
-		return errors.Wrap(err, "invalid grantee address")
-	}
-	if _, err := hex.DecodeString(r.GetRole()); err != nil {
-		return errors.Wrapf(err, "failed to decode role(=%s)", r.GetRole())
-	}
-	return nil
+func (r *NameRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.address("contract_address", r.GetContractAddress())
+	v.block("block", r.GetBlock())
+	return v.err()
 }
 
-func (r *NameRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	return nil
+func (r *SymbolRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.address("contract_address", r.GetContractAddress())
+	v.block("block", r.GetBlock())
+	return v.err()
 }
 
-func (r *SymbolRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	return nil
+func (r *TotalSupplyRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.address("contract_address", r.GetContractAddress())
+	v.block("block", r.GetBlock())
+	return v.err()
 }
 
-func (r *TotalSupplyRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	return nil
+func (r *BalanceOfRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.address("contract_address", r.GetContractAddress())
+	v.address("account", r.GetAccount())
+	v.block("block", r.GetBlock())
+	return v.err()
 }
 
-func (r *BalanceOfRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	if err := validateAddress(r.GetAccount()); err != nil {
-		return errors.Wrap(err, "invalid account address")
-	}
-	return nil
+func (r *TokenInfoRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.address("contract_address", r.GetContractAddress())
+	v.minedBlock("block", r.GetBlock())
+	return v.err()
 }
 
-func (r *HasRoleRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	if err := validateAddress(r.GetAccount()); err != nil {
-		return errors.Wrap(err, "invalid account address")
+func (r *BatchBalanceOfRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.address("contract_address", r.GetContractAddress())
+	if len(r.GetAccounts()) == 0 {
+		v.fail("accounts", ErrRequired)
 	}
-	if _, err := hex.DecodeString(r.GetRole()); err != nil {
-		return errors.Wrapf(err, "failed to decode role(=%s)", r.GetRole())
+	for i, account := range r.GetAccounts() {
+		v.address(fmt.Sprintf("accounts[%d]", i), account)
 	}
-	return nil
+	v.block("block", r.GetBlock())
+	return v.err()
 }
 
-func (r *CreateContractsRequest) Validate() error {
-	if err := validateAddress(r.GetContractAddress()); err != nil {
-		return errors.Wrap(err, "invalid contract address")
-	}
-	if _, err := ToWei(r.GetInitialSupply(), 18); err != nil {
-		return errors.Wrapf(err, "invalid inital supply(=%v)", r.GetInitialSupply())
-	}
+func (r *DeployCSRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.privateKey("private_key", r.GetPrivateKey())
+	return v.err()
+}
+
+func (r *GrantRoleRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.privateKey("private_key", r.GetPrivateKey())
+	v.address("contract_address", r.GetContractAddress())
+	v.role("role", r.GetRole())
+	v.address("grantee", r.GetGrantee())
+	return v.err()
+}
+
+func (r *HasRoleRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.address("contract_address", r.GetContractAddress())
+	v.role("role", r.GetRole())
+	v.address("account", r.GetAccount())
+	v.block("block", r.GetBlock())
+	return v.err()
+}
+
+func (r *ContainsWalletRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.address("contract_address", r.GetContractAddress())
+	v.address("account", r.GetAccount())
+	v.block("block", r.GetBlock())
+	return v.err()
+}
+
+func (r *DeployFCRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.privateKey("private_key", r.GetPrivateKey())
+	return v.err()
+}
+
+func (r *CreateContractsRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
+
+	v.privateKey("private_key", r.GetPrivateKey())
+	v.address("contract_address", r.GetContractAddress())
+	v.required("name", r.GetName())
+	v.required("symbol", r.GetSymbol())
+	v.amountWithDecimals("initialSupply", r.GetInitialSupply(), DefaultDecimals)
 	for i, grantee := range r.GetGrantees() {
-		if err := validateAddress(grantee); err != nil {
-			return errors.Wrapf(err, "invalid grantee address at index %d", i)
-		}
+		v.address(fmt.Sprintf("grantees[%d]", i), grantee)
 	}
-	return nil
+	return v.err()
 }
 
-func ToWei(iamount interface{}, decimals int) (*big.Int, error) {
-	var (
-		amount = decimal.NewFromFloat(0)
-		err    error
-	)
-	switch v := iamount.(type) {
-	case string:
-		if amount, err = decimal.NewFromString(v); err != nil {
-			return nil, errors.Wrap(err, "failed to cast to wei")
-		}
-	case float64:
-		amount = decimal.NewFromFloat(v)
-	case int64:
-		amount = decimal.NewFromFloat(float64(v))
-	case decimal.Decimal:
-		amount = v
-	case *decimal.Decimal:
-		amount = *v
-	}
+func (r *ListFactoryDeploymentsRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
 
-	mul := decimal.NewFromFloat(float64(10)).Pow(decimal.NewFromFloat(float64(decimals)))
-	result := amount.Mul(mul)
+	v.address("contract_address", r.GetContractAddress())
+	v.block("block", r.GetBlock())
+	return v.err()
+}
 
-	wei := new(big.Int)
-	wei.SetString(result.String(), 10)
+func (r *OnboardInvestorRequest) Validate(opts ...ValidateOption) error {
+	v := newValidator(opts...)
 
-	return wei, nil
+	v.privateKey("private_key", r.GetPrivateKey())
+	v.address("contract_address", r.GetContractAddress())
+	v.recipient("account", r.GetAccount(), r.GetContractAddress())
+	v.amount("amount", r.GetAmount())
+	v.txHash("issue_hash", r.GetIssueHash())
+	return v.err()
 }
 
-func validateAddress(address string) error {
-	if address == "0x0000000000000000000000000000000000000000" || address == "0000000000000000000000000000000000000000" {
-		return errors.New("empty ethereum address")
+// ToWei converts a human amount into base units, see ParseAmount for the accepted amounts.
+func ToWei(iamount interface{}, decimals int) (*big.Int, error) {
+	amount, err := ParseAmount(iamount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to cast to wei")
 	}
-	if !common.IsHexAddress(address) {
-		return errors.Errorf("invalid ethereum address")
+	return amount.Wei(decimals)
+}
+
+// FromWei is the counterpart of ToWei, it formats an amount in base units with the given decimals, without trailing zeros.
+func FromWei(wei *big.Int, decimals int) string {
+	if wei == nil {
+		return "0"
 	}
-	return nil
+	return AmountFromWei(wei, decimals).String()
 }
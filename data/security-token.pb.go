@@ -32,20 +32,29 @@ const (
 	RequestType_SEND_ETH       RequestType = 0
 	RequestType_BALANCE_OF_ETH RequestType = 1
 	// st
-	RequestType_DEPLOY_ST       RequestType = 10
-	RequestType_ISSUE           RequestType = 11
-	RequestType_REDEEM          RequestType = 12
-	RequestType_TRANSFER        RequestType = 13
-	RequestType_REGISTER_WALLET RequestType = 14
-	RequestType_TOTAL_SUPPLY    RequestType = 15
-	RequestType_BALANCE_OF      RequestType = 16
+	RequestType_DEPLOY_ST        RequestType = 10
+	RequestType_ISSUE            RequestType = 11
+	RequestType_REDEEM           RequestType = 12
+	RequestType_TRANSFER         RequestType = 13
+	RequestType_REGISTER_WALLET  RequestType = 14
+	RequestType_TOTAL_SUPPLY     RequestType = 15
+	RequestType_BALANCE_OF       RequestType = 16
+	RequestType_NAME             RequestType = 17
+	RequestType_SYMBOL           RequestType = 18
+	RequestType_BATCH_BALANCE_OF RequestType = 19
 	// compliance
-	RequestType_DEPLOY_CS  RequestType = 20
-	RequestType_GRANT_ROLE RequestType = 21
-	RequestType_HAS_ROLE   RequestType = 22
+	RequestType_DEPLOY_CS       RequestType = 20
+	RequestType_GRANT_ROLE      RequestType = 21
+	RequestType_HAS_ROLE        RequestType = 22
+	RequestType_CONTAINS_WALLET RequestType = 23
 	// factory
-	RequestType_DEPLOY_FC        RequestType = 30
-	RequestType_CREATE_CONTRACTS RequestType = 31
+	RequestType_DEPLOY_FC                RequestType = 30
+	RequestType_CREATE_CONTRACTS         RequestType = 31
+	RequestType_LIST_FACTORY_DEPLOYMENTS RequestType = 32
+	// snapshot
+	RequestType_TOKEN_INFO RequestType = 40
+	// workflow
+	RequestType_ONBOARD_INVESTOR RequestType = 50
 )
 
 var RequestType_name = map[int32]string{
@@ -58,28 +67,42 @@ var RequestType_name = map[int32]string{
 	14: "REGISTER_WALLET",
 	15: "TOTAL_SUPPLY",
 	16: "BALANCE_OF",
+	17: "NAME",
+	18: "SYMBOL",
+	19: "BATCH_BALANCE_OF",
 	20: "DEPLOY_CS",
 	21: "GRANT_ROLE",
 	22: "HAS_ROLE",
+	23: "CONTAINS_WALLET",
 	30: "DEPLOY_FC",
 	31: "CREATE_CONTRACTS",
+	32: "LIST_FACTORY_DEPLOYMENTS",
+	40: "TOKEN_INFO",
+	50: "ONBOARD_INVESTOR",
 }
 
 var RequestType_value = map[string]int32{
-	"SEND_ETH":         0,
-	"BALANCE_OF_ETH":   1,
-	"DEPLOY_ST":        10,
-	"ISSUE":            11,
-	"REDEEM":           12,
-	"TRANSFER":         13,
-	"REGISTER_WALLET":  14,
-	"TOTAL_SUPPLY":     15,
-	"BALANCE_OF":       16,
-	"DEPLOY_CS":        20,
-	"GRANT_ROLE":       21,
-	"HAS_ROLE":         22,
-	"DEPLOY_FC":        30,
-	"CREATE_CONTRACTS": 31,
+	"SEND_ETH":                 0,
+	"BALANCE_OF_ETH":           1,
+	"DEPLOY_ST":                10,
+	"ISSUE":                    11,
+	"REDEEM":                   12,
+	"TRANSFER":                 13,
+	"REGISTER_WALLET":          14,
+	"TOTAL_SUPPLY":             15,
+	"BALANCE_OF":               16,
+	"NAME":                     17,
+	"SYMBOL":                   18,
+	"BATCH_BALANCE_OF":         19,
+	"DEPLOY_CS":                20,
+	"GRANT_ROLE":               21,
+	"HAS_ROLE":                 22,
+	"CONTAINS_WALLET":          23,
+	"DEPLOY_FC":                30,
+	"CREATE_CONTRACTS":         31,
+	"LIST_FACTORY_DEPLOYMENTS": 32,
+	"TOKEN_INFO":               40,
+	"ONBOARD_INVESTOR":         50,
 }
 
 func (x RequestType) String() string {
@@ -90,6 +113,37 @@ func (RequestType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0a3532adaf4834d5, []int{0}
 }
 
+type StepStatus int32
+
+const (
+	StepStatus_STEP_PENDING StepStatus = 0
+	StepStatus_STEP_DONE    StepStatus = 1
+	StepStatus_STEP_SKIPPED StepStatus = 2
+	StepStatus_STEP_FAILED  StepStatus = 3
+)
+
+var StepStatus_name = map[int32]string{
+	0: "STEP_PENDING",
+	1: "STEP_DONE",
+	2: "STEP_SKIPPED",
+	3: "STEP_FAILED",
+}
+
+var StepStatus_value = map[string]int32{
+	"STEP_PENDING": 0,
+	"STEP_DONE":    1,
+	"STEP_SKIPPED": 2,
+	"STEP_FAILED":  3,
+}
+
+func (x StepStatus) String() string {
+	return proto.EnumName(StepStatus_name, int32(x))
+}
+
+func (StepStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{1}
+}
+
 // ----- eth -----
 type SendETHRequest struct {
 	PrivateKey string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
@@ -195,6 +249,7 @@ func (m *SendETHResponse) GetHash() string {
 
 type BalanceOfETHRequest struct {
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Block   string `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 }
 
 func (m *BalanceOfETHRequest) Reset()      { *m = BalanceOfETHRequest{} }
@@ -236,8 +291,16 @@ func (m *BalanceOfETHRequest) GetAccount() string {
 	return ""
 }
 
+func (m *BalanceOfETHRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
 type BalanceOfETHResponse struct {
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount          string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	FormattedAmount string `protobuf:"bytes,2,opt,name=formatted_amount,json=formattedAmount,proto3" json:"formatted_amount,omitempty"`
 }
 
 func (m *BalanceOfETHResponse) Reset()      { *m = BalanceOfETHResponse{} }
@@ -279,6 +342,13 @@ func (m *BalanceOfETHResponse) GetAmount() string {
 	return ""
 }
 
+func (m *BalanceOfETHResponse) GetFormattedAmount() string {
+	if m != nil {
+		return m.FormattedAmount
+	}
+	return ""
+}
+
 type DeploySTRequest struct {
 	PrivateKey        string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
 	Name              string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -895,6 +965,7 @@ func (m *RegisterWalletResponse) GetHash() string {
 
 type NameRequest struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Block           string `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 }
 
 func (m *NameRequest) Reset()      { *m = NameRequest{} }
@@ -936,6 +1007,13 @@ func (m *NameRequest) GetContractAddress() string {
 	return ""
 }
 
+func (m *NameRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
 type NameResponse struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
@@ -981,6 +1059,7 @@ func (m *NameResponse) GetName() string {
 
 type SymbolRequest struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Block           string `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 }
 
 func (m *SymbolRequest) Reset()      { *m = SymbolRequest{} }
@@ -1022,6 +1101,13 @@ func (m *SymbolRequest) GetContractAddress() string {
 	return ""
 }
 
+func (m *SymbolRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
 type SymbolResponse struct {
 	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 }
@@ -1067,6 +1153,7 @@ func (m *SymbolResponse) GetSymbol() string {
 
 type TotalSupplyRequest struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Block           string `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 }
 
 func (m *TotalSupplyRequest) Reset()      { *m = TotalSupplyRequest{} }
@@ -1108,8 +1195,17 @@ func (m *TotalSupplyRequest) GetContractAddress() string {
 	return ""
 }
 
+func (m *TotalSupplyRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
 type TotalSupplyResponse struct {
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount          string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimals        uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	FormattedAmount string `protobuf:"bytes,3,opt,name=formatted_amount,json=formattedAmount,proto3" json:"formatted_amount,omitempty"`
 }
 
 func (m *TotalSupplyResponse) Reset()      { *m = TotalSupplyResponse{} }
@@ -1151,9 +1247,24 @@ func (m *TotalSupplyResponse) GetAmount() string {
 	return ""
 }
 
+func (m *TotalSupplyResponse) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *TotalSupplyResponse) GetFormattedAmount() string {
+	if m != nil {
+		return m.FormattedAmount
+	}
+	return ""
+}
+
 type BalanceOfRequest struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	Account         string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Block           string `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
 }
 
 func (m *BalanceOfRequest) Reset()      { *m = BalanceOfRequest{} }
@@ -1202,8 +1313,17 @@ func (m *BalanceOfRequest) GetAccount() string {
 	return ""
 }
 
+func (m *BalanceOfRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
 type BalanceOfResponse struct {
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount          string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimals        uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	FormattedAmount string `protobuf:"bytes,3,opt,name=formatted_amount,json=formattedAmount,proto3" json:"formatted_amount,omitempty"`
 }
 
 func (m *BalanceOfResponse) Reset()      { *m = BalanceOfResponse{} }
@@ -1245,65 +1365,37 @@ func (m *BalanceOfResponse) GetAmount() string {
 	return ""
 }
 
-type DeployCSRequest struct {
-	PrivateKey string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-}
-
-func (m *DeployCSRequest) Reset()      { *m = DeployCSRequest{} }
-func (*DeployCSRequest) ProtoMessage() {}
-func (*DeployCSRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0a3532adaf4834d5, []int{22}
-}
-func (m *DeployCSRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *DeployCSRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_DeployCSRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *BalanceOfResponse) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
 	}
+	return 0
 }
-func (m *DeployCSRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeployCSRequest.Merge(m, src)
-}
-func (m *DeployCSRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *DeployCSRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeployCSRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DeployCSRequest proto.InternalMessageInfo
 
-func (m *DeployCSRequest) GetPrivateKey() string {
+func (m *BalanceOfResponse) GetFormattedAmount() string {
 	if m != nil {
-		return m.PrivateKey
+		return m.FormattedAmount
 	}
 	return ""
 }
 
-type DeployCSResponse struct {
-	Hash            string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+type BatchBalanceOfRequest struct {
+	ContractAddress string   `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Accounts        []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Block           string   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (m *DeployCSResponse) Reset()      { *m = DeployCSResponse{} }
-func (*DeployCSResponse) ProtoMessage() {}
-func (*DeployCSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0a3532adaf4834d5, []int{23}
+func (m *BatchBalanceOfRequest) Reset()      { *m = BatchBalanceOfRequest{} }
+func (*BatchBalanceOfRequest) ProtoMessage() {}
+func (*BatchBalanceOfRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{22}
 }
-func (m *DeployCSResponse) XXX_Unmarshal(b []byte) error {
+func (m *BatchBalanceOfRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeployCSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchBalanceOfRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeployCSResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchBalanceOfRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1313,50 +1405,57 @@ func (m *DeployCSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DeployCSResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeployCSResponse.Merge(m, src)
+func (m *BatchBalanceOfRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchBalanceOfRequest.Merge(m, src)
 }
-func (m *DeployCSResponse) XXX_Size() int {
+func (m *BatchBalanceOfRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeployCSResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeployCSResponse.DiscardUnknown(m)
+func (m *BatchBalanceOfRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchBalanceOfRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeployCSResponse proto.InternalMessageInfo
+var xxx_messageInfo_BatchBalanceOfRequest proto.InternalMessageInfo
 
-func (m *DeployCSResponse) GetHash() string {
+func (m *BatchBalanceOfRequest) GetContractAddress() string {
 	if m != nil {
-		return m.Hash
+		return m.ContractAddress
 	}
 	return ""
 }
 
-func (m *DeployCSResponse) GetContractAddress() string {
+func (m *BatchBalanceOfRequest) GetAccounts() []string {
 	if m != nil {
-		return m.ContractAddress
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *BatchBalanceOfRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
 	}
 	return ""
 }
 
-type GrantRoleRequest struct {
-	PrivateKey      string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	Role            string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	Grantee         string `protobuf:"bytes,4,opt,name=grantee,proto3" json:"grantee,omitempty"`
+type AccountBalance struct {
+	Account         string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount          string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	FormattedAmount string `protobuf:"bytes,3,opt,name=formatted_amount,json=formattedAmount,proto3" json:"formatted_amount,omitempty"`
+	Error           string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *GrantRoleRequest) Reset()      { *m = GrantRoleRequest{} }
-func (*GrantRoleRequest) ProtoMessage() {}
-func (*GrantRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0a3532adaf4834d5, []int{24}
+func (m *AccountBalance) Reset()      { *m = AccountBalance{} }
+func (*AccountBalance) ProtoMessage() {}
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{23}
 }
-func (m *GrantRoleRequest) XXX_Unmarshal(b []byte) error {
+func (m *AccountBalance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GrantRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GrantRoleRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccountBalance.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1366,61 +1465,62 @@ func (m *GrantRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GrantRoleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GrantRoleRequest.Merge(m, src)
+func (m *AccountBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBalance.Merge(m, src)
 }
-func (m *GrantRoleRequest) XXX_Size() int {
+func (m *AccountBalance) XXX_Size() int {
 	return m.Size()
 }
-func (m *GrantRoleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GrantRoleRequest.DiscardUnknown(m)
+func (m *AccountBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBalance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GrantRoleRequest proto.InternalMessageInfo
+var xxx_messageInfo_AccountBalance proto.InternalMessageInfo
 
-func (m *GrantRoleRequest) GetPrivateKey() string {
+func (m *AccountBalance) GetAccount() string {
 	if m != nil {
-		return m.PrivateKey
+		return m.Account
 	}
 	return ""
 }
 
-func (m *GrantRoleRequest) GetContractAddress() string {
+func (m *AccountBalance) GetAmount() string {
 	if m != nil {
-		return m.ContractAddress
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *GrantRoleRequest) GetRole() string {
+func (m *AccountBalance) GetFormattedAmount() string {
 	if m != nil {
-		return m.Role
+		return m.FormattedAmount
 	}
 	return ""
 }
 
-func (m *GrantRoleRequest) GetGrantee() string {
+func (m *AccountBalance) GetError() string {
 	if m != nil {
-		return m.Grantee
+		return m.Error
 	}
 	return ""
 }
 
-type GrantRoleResponse struct {
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+type BatchBalanceOfResponse struct {
+	Decimals uint32            `protobuf:"varint,1,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Balances []*AccountBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
 }
 
-func (m *GrantRoleResponse) Reset()      { *m = GrantRoleResponse{} }
-func (*GrantRoleResponse) ProtoMessage() {}
-func (*GrantRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0a3532adaf4834d5, []int{25}
+func (m *BatchBalanceOfResponse) Reset()      { *m = BatchBalanceOfResponse{} }
+func (*BatchBalanceOfResponse) ProtoMessage() {}
+func (*BatchBalanceOfResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{24}
 }
-func (m *GrantRoleResponse) XXX_Unmarshal(b []byte) error {
+func (m *BatchBalanceOfResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchBalanceOfResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GrantRoleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchBalanceOfResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1430,42 +1530,48 @@ func (m *GrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GrantRoleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GrantRoleResponse.Merge(m, src)
+func (m *BatchBalanceOfResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchBalanceOfResponse.Merge(m, src)
 }
-func (m *GrantRoleResponse) XXX_Size() int {
+func (m *BatchBalanceOfResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GrantRoleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GrantRoleResponse.DiscardUnknown(m)
+func (m *BatchBalanceOfResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchBalanceOfResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GrantRoleResponse proto.InternalMessageInfo
+var xxx_messageInfo_BatchBalanceOfResponse proto.InternalMessageInfo
 
-func (m *GrantRoleResponse) GetHash() string {
+func (m *BatchBalanceOfResponse) GetDecimals() uint32 {
 	if m != nil {
-		return m.Hash
+		return m.Decimals
 	}
-	return ""
+	return 0
 }
 
-type HasRoleRequest struct {
+func (m *BatchBalanceOfResponse) GetBalances() []*AccountBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+type TokenInfoRequest struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	Role            string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	Account         string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Block           string `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (m *HasRoleRequest) Reset()      { *m = HasRoleRequest{} }
-func (*HasRoleRequest) ProtoMessage() {}
-func (*HasRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0a3532adaf4834d5, []int{26}
+func (m *TokenInfoRequest) Reset()      { *m = TokenInfoRequest{} }
+func (*TokenInfoRequest) ProtoMessage() {}
+func (*TokenInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{25}
 }
-func (m *HasRoleRequest) XXX_Unmarshal(b []byte) error {
+func (m *TokenInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HasRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TokenInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HasRoleRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TokenInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1475,54 +1581,59 @@ func (m *HasRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *HasRoleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasRoleRequest.Merge(m, src)
+func (m *TokenInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenInfoRequest.Merge(m, src)
 }
-func (m *HasRoleRequest) XXX_Size() int {
+func (m *TokenInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *HasRoleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasRoleRequest.DiscardUnknown(m)
+func (m *TokenInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasRoleRequest proto.InternalMessageInfo
+var xxx_messageInfo_TokenInfoRequest proto.InternalMessageInfo
 
-func (m *HasRoleRequest) GetContractAddress() string {
+func (m *TokenInfoRequest) GetContractAddress() string {
 	if m != nil {
 		return m.ContractAddress
 	}
 	return ""
 }
 
-func (m *HasRoleRequest) GetRole() string {
-	if m != nil {
-		return m.Role
-	}
-	return ""
-}
-
-func (m *HasRoleRequest) GetAccount() string {
+func (m *TokenInfoRequest) GetBlock() string {
 	if m != nil {
-		return m.Account
+		return m.Block
 	}
 	return ""
 }
 
-type HasRoleResponse struct {
-	Has bool `protobuf:"varint,1,opt,name=has,proto3" json:"has,omitempty"`
-}
-
-func (m *HasRoleResponse) Reset()      { *m = HasRoleResponse{} }
-func (*HasRoleResponse) ProtoMessage() {}
-func (*HasRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0a3532adaf4834d5, []int{27}
+// TokenInfoResponse is the state of a token and its compliance service, all read at block_number
+type TokenInfoResponse struct {
+	Name                 string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol               string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals             uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	TotalSupply          string `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	FormattedTotalSupply string `protobuf:"bytes,5,opt,name=formatted_total_supply,json=formattedTotalSupply,proto3" json:"formatted_total_supply,omitempty"`
+	ComplianceAddress    string `protobuf:"bytes,6,opt,name=compliance_address,json=complianceAddress,proto3" json:"compliance_address,omitempty"`
+	ComplianceVersion    uint32 `protobuf:"varint,7,opt,name=compliance_version,json=complianceVersion,proto3" json:"compliance_version,omitempty"`
+	DocumentCount        uint64 `protobuf:"varint,8,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
+	Paused               bool   `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	TransferPaused       bool   `protobuf:"varint,10,opt,name=transfer_paused,json=transferPaused,proto3" json:"transfer_paused,omitempty"`
+	WalletCount          uint64 `protobuf:"varint,11,opt,name=wallet_count,json=walletCount,proto3" json:"wallet_count,omitempty"`
+	BlockNumber          uint64 `protobuf:"varint,12,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (m *TokenInfoResponse) Reset()      { *m = TokenInfoResponse{} }
+func (*TokenInfoResponse) ProtoMessage() {}
+func (*TokenInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{26}
 }
-func (m *HasRoleResponse) XXX_Unmarshal(b []byte) error {
+func (m *TokenInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HasRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TokenInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HasRoleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TokenInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1532,84 +1643,161 @@ func (m *HasRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *HasRoleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasRoleResponse.Merge(m, src)
+func (m *TokenInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenInfoResponse.Merge(m, src)
 }
-func (m *HasRoleResponse) XXX_Size() int {
+func (m *TokenInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *HasRoleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasRoleResponse.DiscardUnknown(m)
+func (m *TokenInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasRoleResponse proto.InternalMessageInfo
+var xxx_messageInfo_TokenInfoResponse proto.InternalMessageInfo
 
-func (m *HasRoleResponse) GetHas() bool {
+func (m *TokenInfoResponse) GetName() string {
 	if m != nil {
-		return m.Has
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-type DeployFCRequest struct {
-	PrivateKey string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+func (m *TokenInfoResponse) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
 }
 
-func (m *DeployFCRequest) Reset()      { *m = DeployFCRequest{} }
-func (*DeployFCRequest) ProtoMessage() {}
-func (*DeployFCRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0a3532adaf4834d5, []int{28}
-}
-func (m *DeployFCRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *TokenInfoResponse) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
 }
-func (m *DeployFCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_DeployFCRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *TokenInfoResponse) GetTotalSupply() string {
+	if m != nil {
+		return m.TotalSupply
 	}
+	return ""
 }
-func (m *DeployFCRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeployFCRequest.Merge(m, src)
+
+func (m *TokenInfoResponse) GetFormattedTotalSupply() string {
+	if m != nil {
+		return m.FormattedTotalSupply
+	}
+	return ""
 }
-func (m *DeployFCRequest) XXX_Size() int {
+
+func (m *TokenInfoResponse) GetComplianceAddress() string {
+	if m != nil {
+		return m.ComplianceAddress
+	}
+	return ""
+}
+
+func (m *TokenInfoResponse) GetComplianceVersion() uint32 {
+	if m != nil {
+		return m.ComplianceVersion
+	}
+	return 0
+}
+
+func (m *TokenInfoResponse) GetDocumentCount() uint64 {
+	if m != nil {
+		return m.DocumentCount
+	}
+	return 0
+}
+
+func (m *TokenInfoResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *TokenInfoResponse) GetTransferPaused() bool {
+	if m != nil {
+		return m.TransferPaused
+	}
+	return false
+}
+
+func (m *TokenInfoResponse) GetWalletCount() uint64 {
+	if m != nil {
+		return m.WalletCount
+	}
+	return 0
+}
+
+func (m *TokenInfoResponse) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+type DeployCSRequest struct {
+	PrivateKey string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+}
+
+func (m *DeployCSRequest) Reset()      { *m = DeployCSRequest{} }
+func (*DeployCSRequest) ProtoMessage() {}
+func (*DeployCSRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{27}
+}
+func (m *DeployCSRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeployCSRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeployCSRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeployCSRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployCSRequest.Merge(m, src)
+}
+func (m *DeployCSRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeployFCRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeployFCRequest.DiscardUnknown(m)
+func (m *DeployCSRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployCSRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeployFCRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeployCSRequest proto.InternalMessageInfo
 
-func (m *DeployFCRequest) GetPrivateKey() string {
+func (m *DeployCSRequest) GetPrivateKey() string {
 	if m != nil {
 		return m.PrivateKey
 	}
 	return ""
 }
 
-type DeployFCResponse struct {
+type DeployCSResponse struct {
 	Hash            string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (m *DeployFCResponse) Reset()      { *m = DeployFCResponse{} }
-func (*DeployFCResponse) ProtoMessage() {}
-func (*DeployFCResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0a3532adaf4834d5, []int{29}
+func (m *DeployCSResponse) Reset()      { *m = DeployCSResponse{} }
+func (*DeployCSResponse) ProtoMessage() {}
+func (*DeployCSResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{28}
 }
-func (m *DeployFCResponse) XXX_Unmarshal(b []byte) error {
+func (m *DeployCSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeployFCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeployCSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeployFCResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeployCSResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1619,52 +1807,50 @@ func (m *DeployFCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DeployFCResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeployFCResponse.Merge(m, src)
+func (m *DeployCSResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployCSResponse.Merge(m, src)
 }
-func (m *DeployFCResponse) XXX_Size() int {
+func (m *DeployCSResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeployFCResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeployFCResponse.DiscardUnknown(m)
+func (m *DeployCSResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployCSResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeployFCResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeployCSResponse proto.InternalMessageInfo
 
-func (m *DeployFCResponse) GetHash() string {
+func (m *DeployCSResponse) GetHash() string {
 	if m != nil {
 		return m.Hash
 	}
 	return ""
 }
 
-func (m *DeployFCResponse) GetContractAddress() string {
+func (m *DeployCSResponse) GetContractAddress() string {
 	if m != nil {
 		return m.ContractAddress
 	}
 	return ""
 }
 
-type CreateContractsRequest struct {
-	PrivateKey      string   `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	ContractAddress string   `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	Name            string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol          string   `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	InitialSupply   string   `protobuf:"bytes,5,opt,name=initialSupply,proto3" json:"initialSupply,omitempty"`
-	Grantees        []string `protobuf:"bytes,6,rep,name=grantees,proto3" json:"grantees,omitempty"`
+type GrantRoleRequest struct {
+	PrivateKey      string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Role            string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Grantee         string `protobuf:"bytes,4,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
-func (m *CreateContractsRequest) Reset()      { *m = CreateContractsRequest{} }
-func (*CreateContractsRequest) ProtoMessage() {}
-func (*CreateContractsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0a3532adaf4834d5, []int{30}
+func (m *GrantRoleRequest) Reset()      { *m = GrantRoleRequest{} }
+func (*GrantRoleRequest) ProtoMessage() {}
+func (*GrantRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{29}
 }
-func (m *CreateContractsRequest) XXX_Unmarshal(b []byte) error {
+func (m *GrantRoleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GrantRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateContractsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GrantRoleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1674,77 +1860,107 @@ func (m *CreateContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *CreateContractsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateContractsRequest.Merge(m, src)
+func (m *GrantRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantRoleRequest.Merge(m, src)
 }
-func (m *CreateContractsRequest) XXX_Size() int {
+func (m *GrantRoleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateContractsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateContractsRequest.DiscardUnknown(m)
+func (m *GrantRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateContractsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GrantRoleRequest proto.InternalMessageInfo
 
-func (m *CreateContractsRequest) GetPrivateKey() string {
+func (m *GrantRoleRequest) GetPrivateKey() string {
 	if m != nil {
 		return m.PrivateKey
 	}
 	return ""
 }
 
-func (m *CreateContractsRequest) GetContractAddress() string {
+func (m *GrantRoleRequest) GetContractAddress() string {
 	if m != nil {
 		return m.ContractAddress
 	}
 	return ""
 }
 
-func (m *CreateContractsRequest) GetName() string {
+func (m *GrantRoleRequest) GetRole() string {
 	if m != nil {
-		return m.Name
+		return m.Role
 	}
 	return ""
 }
 
-func (m *CreateContractsRequest) GetSymbol() string {
+func (m *GrantRoleRequest) GetGrantee() string {
 	if m != nil {
-		return m.Symbol
+		return m.Grantee
 	}
 	return ""
 }
 
-func (m *CreateContractsRequest) GetInitialSupply() string {
-	if m != nil {
-		return m.InitialSupply
+type GrantRoleResponse struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GrantRoleResponse) Reset()      { *m = GrantRoleResponse{} }
+func (*GrantRoleResponse) ProtoMessage() {}
+func (*GrantRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{30}
+}
+func (m *GrantRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GrantRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantRoleResponse.Merge(m, src)
+}
+func (m *GrantRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantRoleResponse.DiscardUnknown(m)
 }
 
-func (m *CreateContractsRequest) GetGrantees() []string {
+var xxx_messageInfo_GrantRoleResponse proto.InternalMessageInfo
+
+func (m *GrantRoleResponse) GetHash() string {
 	if m != nil {
-		return m.Grantees
+		return m.Hash
 	}
-	return nil
+	return ""
 }
 
-type CreateContractsResponse struct {
-	Hash              string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	ComplianceAddress string `protobuf:"bytes,2,opt,name=compliance_address,json=complianceAddress,proto3" json:"compliance_address,omitempty"`
-	TokenAddress      string `protobuf:"bytes,3,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+type HasRoleRequest struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Role            string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Account         string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Block           string `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (m *CreateContractsResponse) Reset()      { *m = CreateContractsResponse{} }
-func (*CreateContractsResponse) ProtoMessage() {}
-func (*CreateContractsResponse) Descriptor() ([]byte, []int) {
+func (m *HasRoleRequest) Reset()      { *m = HasRoleRequest{} }
+func (*HasRoleRequest) ProtoMessage() {}
+func (*HasRoleRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0a3532adaf4834d5, []int{31}
 }
-func (m *CreateContractsResponse) XXX_Unmarshal(b []byte) error {
+func (m *HasRoleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HasRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateContractsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HasRoleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1754,504 +1970,1036 @@ func (m *CreateContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *CreateContractsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateContractsResponse.Merge(m, src)
+func (m *HasRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasRoleRequest.Merge(m, src)
 }
-func (m *CreateContractsResponse) XXX_Size() int {
+func (m *HasRoleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateContractsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateContractsResponse.DiscardUnknown(m)
+func (m *HasRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateContractsResponse proto.InternalMessageInfo
+var xxx_messageInfo_HasRoleRequest proto.InternalMessageInfo
 
-func (m *CreateContractsResponse) GetHash() string {
+func (m *HasRoleRequest) GetContractAddress() string {
 	if m != nil {
-		return m.Hash
+		return m.ContractAddress
 	}
 	return ""
 }
 
-func (m *CreateContractsResponse) GetComplianceAddress() string {
+func (m *HasRoleRequest) GetRole() string {
 	if m != nil {
-		return m.ComplianceAddress
+		return m.Role
 	}
 	return ""
 }
 
-func (m *CreateContractsResponse) GetTokenAddress() string {
+func (m *HasRoleRequest) GetAccount() string {
 	if m != nil {
-		return m.TokenAddress
+		return m.Account
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("angoya.stoserver.data.RequestType", RequestType_name, RequestType_value)
-	proto.RegisterType((*SendETHRequest)(nil), "angoya.stoserver.data.SendETHRequest")
-	proto.RegisterType((*SendETHResponse)(nil), "angoya.stoserver.data.SendETHResponse")
-	proto.RegisterType((*BalanceOfETHRequest)(nil), "angoya.stoserver.data.BalanceOfETHRequest")
-	proto.RegisterType((*BalanceOfETHResponse)(nil), "angoya.stoserver.data.BalanceOfETHResponse")
-	proto.RegisterType((*DeploySTRequest)(nil), "angoya.stoserver.data.DeploySTRequest")
-	proto.RegisterType((*DeploySTResponse)(nil), "angoya.stoserver.data.DeploySTResponse")
-	proto.RegisterType((*IssueRequest)(nil), "angoya.stoserver.data.IssueRequest")
-	proto.RegisterType((*IssueResponse)(nil), "angoya.stoserver.data.IssueResponse")
-	proto.RegisterType((*RedeemRequest)(nil), "angoya.stoserver.data.RedeemRequest")
-	proto.RegisterType((*RedeemResponse)(nil), "angoya.stoserver.data.RedeemResponse")
-	proto.RegisterType((*TransferRequest)(nil), "angoya.stoserver.data.TransferRequest")
-	proto.RegisterType((*TransferResponse)(nil), "angoya.stoserver.data.TransferResponse")
-	proto.RegisterType((*RegisterWalletRequest)(nil), "angoya.stoserver.data.RegisterWalletRequest")
-	proto.RegisterType((*RegisterWalletResponse)(nil), "angoya.stoserver.data.RegisterWalletResponse")
-	proto.RegisterType((*NameRequest)(nil), "angoya.stoserver.data.NameRequest")
-	proto.RegisterType((*NameResponse)(nil), "angoya.stoserver.data.NameResponse")
-	proto.RegisterType((*SymbolRequest)(nil), "angoya.stoserver.data.SymbolRequest")
-	proto.RegisterType((*SymbolResponse)(nil), "angoya.stoserver.data.SymbolResponse")
-	proto.RegisterType((*TotalSupplyRequest)(nil), "angoya.stoserver.data.TotalSupplyRequest")
-	proto.RegisterType((*TotalSupplyResponse)(nil), "angoya.stoserver.data.TotalSupplyResponse")
-	proto.RegisterType((*BalanceOfRequest)(nil), "angoya.stoserver.data.BalanceOfRequest")
-	proto.RegisterType((*BalanceOfResponse)(nil), "angoya.stoserver.data.BalanceOfResponse")
-	proto.RegisterType((*DeployCSRequest)(nil), "angoya.stoserver.data.DeployCSRequest")
-	proto.RegisterType((*DeployCSResponse)(nil), "angoya.stoserver.data.DeployCSResponse")
-	proto.RegisterType((*GrantRoleRequest)(nil), "angoya.stoserver.data.GrantRoleRequest")
-	proto.RegisterType((*GrantRoleResponse)(nil), "angoya.stoserver.data.GrantRoleResponse")
-	proto.RegisterType((*HasRoleRequest)(nil), "angoya.stoserver.data.HasRoleRequest")
-	proto.RegisterType((*HasRoleResponse)(nil), "angoya.stoserver.data.HasRoleResponse")
-	proto.RegisterType((*DeployFCRequest)(nil), "angoya.stoserver.data.DeployFCRequest")
-	proto.RegisterType((*DeployFCResponse)(nil), "angoya.stoserver.data.DeployFCResponse")
-	proto.RegisterType((*CreateContractsRequest)(nil), "angoya.stoserver.data.CreateContractsRequest")
-	proto.RegisterType((*CreateContractsResponse)(nil), "angoya.stoserver.data.CreateContractsResponse")
+func (m *HasRoleRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("security-token.proto", fileDescriptor_0a3532adaf4834d5) }
+type HasRoleResponse struct {
+	Has bool `protobuf:"varint,1,opt,name=has,proto3" json:"has,omitempty"`
+}
 
-var fileDescriptor_0a3532adaf4834d5 = []byte{
-	// 1024 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4b, 0x6f, 0xdb, 0x46,
-	0x10, 0xd6, 0x5a, 0xb2, 0x22, 0x8d, 0xf5, 0x58, 0xaf, 0x1f, 0x55, 0xd3, 0x82, 0x31, 0x68, 0xb7,
-	0x75, 0x1f, 0xb6, 0x80, 0xf6, 0x52, 0xf4, 0x52, 0xd0, 0x34, 0xfd, 0x40, 0x55, 0xc9, 0x25, 0x19,
-	0x18, 0xe9, 0x85, 0x58, 0x53, 0x1b, 0x89, 0x08, 0x45, 0xaa, 0x5c, 0x2a, 0x00, 0x6f, 0xed, 0xbd,
-	0x87, 0x1e, 0xfb, 0x07, 0x0a, 0xf4, 0x5e, 0xf4, 0x3f, 0x04, 0xe8, 0x25, 0xe8, 0x29, 0xc7, 0x46,
-	0xbe, 0xf6, 0xd0, 0x9f, 0x50, 0x90, 0x22, 0x45, 0x2a, 0x95, 0x15, 0x3b, 0x88, 0x0b, 0xe4, 0xb6,
-	0xb3, 0x33, 0x3b, 0xf3, 0x7d, 0x3b, 0xb3, 0xc3, 0x21, 0xac, 0x73, 0x66, 0x8e, 0x3c, 0xcb, 0x0f,
-	0xf6, 0x7c, 0xf7, 0x11, 0x73, 0xf6, 0x87, 0x9e, 0xeb, 0xbb, 0x64, 0x83, 0x3a, 0x3d, 0x37, 0xa0,
-	0xfb, 0xdc, 0x77, 0x39, 0xf3, 0x1e, 0x33, 0x6f, 0xbf, 0x4b, 0x7d, 0x7a, 0x77, 0xbd, 0xe7, 0xf6,
-	0xdc, 0xc8, 0xa2, 0x19, 0xae, 0x26, 0xc6, 0x62, 0x0f, 0x6a, 0x1a, 0x73, 0xba, 0x8a, 0x7e, 0xa2,
-	0xb2, 0xef, 0x46, 0x8c, 0xfb, 0xe4, 0x1e, 0xac, 0x0c, 0x3d, 0xeb, 0x31, 0xf5, 0x99, 0xf1, 0x88,
-	0x05, 0x0d, 0xb4, 0x85, 0x76, 0xcb, 0x2a, 0xc4, 0x5b, 0x5f, 0xb1, 0x80, 0xbc, 0x0b, 0x65, 0x8f,
-	0x99, 0xd6, 0xd0, 0x62, 0x8e, 0xdf, 0x58, 0x8a, 0xd4, 0xe9, 0x06, 0xd9, 0x84, 0x22, 0x1d, 0xb8,
-	0x23, 0xc7, 0x6f, 0xe4, 0x23, 0x55, 0x2c, 0x89, 0xef, 0x41, 0x7d, 0x1a, 0x88, 0x0f, 0x5d, 0x87,
-	0x33, 0x42, 0xa0, 0xd0, 0xa7, 0xbc, 0x1f, 0x87, 0x88, 0xd6, 0x62, 0x13, 0xd6, 0x0e, 0xa8, 0x4d,
-	0x1d, 0x93, 0x75, 0x1e, 0x66, 0x40, 0x35, 0xe0, 0x0e, 0x35, 0xcd, 0xc8, 0xed, 0xc4, 0x3a, 0x11,
-	0xc5, 0x7d, 0x58, 0x9f, 0x3d, 0x10, 0x3b, 0x4f, 0x71, 0xa0, 0x19, 0x1c, 0xbf, 0x21, 0xa8, 0x1f,
-	0xb2, 0xa1, 0xed, 0x06, 0x9a, 0x7e, 0x6d, 0xca, 0x04, 0x0a, 0x0e, 0x1d, 0xb0, 0x98, 0x6d, 0xb4,
-	0x0e, 0x03, 0xf0, 0x60, 0x70, 0xe1, 0xda, 0x09, 0xd1, 0x89, 0x44, 0x76, 0xa0, 0x6a, 0x39, 0x96,
-	0x6f, 0x51, 0x5b, 0x1b, 0x0d, 0x87, 0x76, 0xd0, 0x28, 0x44, 0xea, 0xd9, 0x4d, 0xb2, 0x07, 0xc4,
-	0x74, 0x07, 0x43, 0xdb, 0x0a, 0x91, 0x1b, 0xb4, 0xdb, 0xf5, 0x18, 0xe7, 0x8d, 0xe5, 0xc8, 0x74,
-	0x35, 0xd5, 0x48, 0x13, 0x85, 0xf8, 0x0d, 0xe0, 0x14, 0xf4, 0xd5, 0xd7, 0x47, 0x3e, 0x04, 0x6c,
-	0xba, 0x8e, 0xef, 0x51, 0xd3, 0x9f, 0x3a, 0x9d, 0x80, 0xae, 0x27, 0xfb, 0x89, 0xcb, 0x27, 0x08,
-	0x2a, 0xa7, 0x9c, 0x8f, 0xd8, 0xb5, 0x6f, 0xe1, 0xfa, 0xce, 0x67, 0x6b, 0x24, 0x7f, 0x75, 0x8d,
-	0x14, 0xb2, 0xb9, 0x21, 0x6f, 0x43, 0xc9, 0xe2, 0x06, 0xe5, 0x81, 0x63, 0x46, 0x57, 0x51, 0x52,
-	0xef, 0x58, 0x5c, 0x0a, 0x45, 0xf2, 0x0e, 0x94, 0x7b, 0x94, 0x1b, 0xb6, 0x35, 0xb0, 0xfc, 0x46,
-	0x71, 0x0b, 0xed, 0x16, 0xd4, 0x52, 0x8f, 0xf2, 0x56, 0x28, 0x8b, 0xdb, 0x50, 0x8d, 0x99, 0x2c,
-	0xa8, 0xac, 0x5f, 0x10, 0x54, 0x55, 0xd6, 0x65, 0x6c, 0x70, 0x1b, 0x84, 0x33, 0x05, 0x9a, 0x9f,
-	0x29, 0xd0, 0x2b, 0xc9, 0x6e, 0x42, 0xd1, 0x63, 0x94, 0xbb, 0x4e, 0x9c, 0xf5, 0x58, 0x12, 0x77,
-	0xa0, 0x96, 0xc0, 0x5c, 0xc0, 0xe6, 0x0f, 0x04, 0x75, 0xdd, 0xa3, 0x0e, 0x7f, 0xc8, 0xbc, 0x37,
-	0x3f, 0x81, 0xef, 0x03, 0x4e, 0xc9, 0x2c, 0x60, 0xfd, 0x3b, 0x82, 0x0d, 0x95, 0xf5, 0x2c, 0xee,
-	0x33, 0xef, 0x9c, 0xda, 0x36, 0xf3, 0xff, 0xdf, 0x5c, 0x66, 0xf9, 0x15, 0x16, 0xf0, 0x5b, 0x7e,
-	0x81, 0xdf, 0x27, 0xb0, 0xf9, 0x22, 0xec, 0x05, 0x2c, 0x3f, 0x87, 0x95, 0x36, 0x1d, 0x4c, 0xdf,
-	0xe5, 0x3c, 0xe4, 0x68, 0xfe, 0x9b, 0x16, 0xa1, 0x32, 0x39, 0x99, 0x7a, 0x8f, 0xfa, 0x16, 0x4a,
-	0xfb, 0x96, 0xf8, 0x05, 0x54, 0xb5, 0xa8, 0x53, 0xbd, 0x82, 0xff, 0x5d, 0xa8, 0x25, 0x67, 0xd3,
-	0x36, 0x1b, 0x77, 0x41, 0x94, 0xed, 0x82, 0xe2, 0x97, 0x40, 0x74, 0xd7, 0x4f, 0xda, 0xdd, 0x2b,
-	0x84, 0xda, 0x83, 0xb5, 0x19, 0x07, 0x2f, 0x69, 0xeb, 0xe7, 0x80, 0xa7, 0x9f, 0x81, 0x9b, 0x47,
-	0xcb, 0xa6, 0x7c, 0x69, 0xf6, 0xfb, 0xf2, 0x31, 0xac, 0x66, 0x1c, 0xbf, 0x04, 0xc5, 0xa7, 0xc9,
-	0xb7, 0x45, 0xd6, 0xae, 0x5b, 0x98, 0x69, 0x6b, 0x0f, 0xcf, 0xbc, 0x9e, 0xd6, 0xfe, 0x23, 0x02,
-	0x7c, 0xec, 0x51, 0xc7, 0x57, 0x5d, 0xfb, 0x56, 0xda, 0x3b, 0x81, 0x82, 0xe7, 0xda, 0x2c, 0x7e,
-	0x1e, 0xd1, 0x3a, 0xbc, 0xc2, 0x5e, 0x18, 0x93, 0xb1, 0xb8, 0x29, 0x24, 0xa2, 0xf8, 0x01, 0xac,
-	0x66, 0xd0, 0x2c, 0x28, 0x7c, 0x0b, 0x6a, 0x27, 0x94, 0x67, 0x41, 0xdf, 0x20, 0x85, 0x09, 0xa6,
-	0xa5, 0x59, 0x4c, 0xf3, 0x5f, 0xb2, 0xb8, 0x0d, 0xf5, 0x69, 0xa8, 0x18, 0x11, 0x86, 0x7c, 0x9f,
-	0x4e, 0xdc, 0x97, 0xd4, 0x70, 0x99, 0xa6, 0xf3, 0x48, 0xbe, 0x79, 0x3a, 0xc3, 0x33, 0xaf, 0x27,
-	0x9d, 0x7f, 0x22, 0xd8, 0x94, 0x3d, 0x46, 0x7d, 0x26, 0xc7, 0x1a, 0x7e, 0x4b, 0x49, 0x8d, 0x9a,
-	0x45, 0x7e, 0xee, 0x90, 0x53, 0x58, 0x3c, 0xe4, 0x2c, 0xcf, 0x1b, 0x72, 0xee, 0x42, 0x29, 0xae,
-	0x01, 0xde, 0x28, 0x6e, 0xe5, 0x77, 0xcb, 0xea, 0x54, 0x16, 0x7f, 0x40, 0xf0, 0xd6, 0x7f, 0x48,
-	0x2d, 0xb8, 0xaf, 0xf9, 0x03, 0xd3, 0xd2, 0x15, 0x03, 0x13, 0xd9, 0x86, 0x6a, 0x34, 0x13, 0x4f,
-	0x2d, 0x27, 0xac, 0x2a, 0xd1, 0x66, 0x6c, 0xf4, 0xd1, 0xdf, 0x08, 0x56, 0xe2, 0x9b, 0xd4, 0x83,
-	0x21, 0x23, 0x15, 0x28, 0x69, 0x4a, 0xfb, 0xd0, 0x50, 0xf4, 0x13, 0x9c, 0x23, 0x04, 0x6a, 0x07,
-	0x52, 0x4b, 0x6a, 0xcb, 0x8a, 0xd1, 0x39, 0x8a, 0xf6, 0x10, 0xa9, 0x42, 0xf9, 0x50, 0x39, 0x6b,
-	0x75, 0x1e, 0x18, 0x9a, 0x8e, 0x81, 0x94, 0x61, 0xf9, 0x54, 0xd3, 0xee, 0x2b, 0x78, 0x85, 0x00,
-	0x14, 0x55, 0xe5, 0x50, 0x51, 0xbe, 0xc6, 0x95, 0xd0, 0x8f, 0xae, 0x4a, 0x6d, 0xed, 0x48, 0x51,
-	0x71, 0x95, 0xac, 0x41, 0x5d, 0x55, 0x8e, 0x4f, 0x35, 0x5d, 0x51, 0x8d, 0x73, 0xa9, 0xd5, 0x52,
-	0x74, 0x5c, 0x23, 0x18, 0x2a, 0x7a, 0x47, 0x97, 0x5a, 0x86, 0x76, 0xff, 0xec, 0xac, 0xf5, 0x00,
-	0xd7, 0x49, 0x0d, 0x20, 0x0d, 0x87, 0x71, 0x26, 0x94, 0xac, 0xe1, 0xf5, 0x50, 0x7d, 0xac, 0x4a,
-	0x6d, 0xdd, 0x50, 0x3b, 0x2d, 0x05, 0x6f, 0x84, 0x31, 0x4e, 0x24, 0x6d, 0x22, 0x6d, 0x66, 0x8c,
-	0x8f, 0x64, 0x2c, 0x90, 0x75, 0xc0, 0xb2, 0xaa, 0x48, 0xba, 0x62, 0xc8, 0x9d, 0xb6, 0xae, 0x4a,
-	0xb2, 0xae, 0xe1, 0x7b, 0x07, 0xea, 0xb3, 0xe7, 0x42, 0xee, 0x9f, 0xe7, 0x02, 0xfa, 0x7e, 0x2c,
-	0xa0, 0x5f, 0xc7, 0x02, 0x7a, 0x32, 0x16, 0xd0, 0xd3, 0xb1, 0x80, 0xfe, 0x1a, 0x0b, 0xe8, 0xa7,
-	0x4b, 0x21, 0xf7, 0xf3, 0xa5, 0x90, 0x7b, 0x7a, 0x29, 0xe4, 0x9e, 0x5d, 0x0a, 0xb9, 0x6f, 0x77,
-	0x7a, 0x96, 0xdf, 0x1f, 0x5d, 0xec, 0x9b, 0xee, 0xa0, 0x19, 0xfe, 0x51, 0xec, 0x05, 0xb4, 0x69,
-	0xf6, 0xa9, 0xe5, 0xec, 0x99, 0x76, 0x38, 0x04, 0x34, 0xc3, 0xbf, 0x8a, 0x8b, 0x62, 0xf4, 0x1b,
-	0xf1, 0xd9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xdf, 0x49, 0x7e, 0x8b, 0x0c, 0x00, 0x00,
+func (m *HasRoleResponse) Reset()      { *m = HasRoleResponse{} }
+func (*HasRoleResponse) ProtoMessage() {}
+func (*HasRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{32}
+}
+func (m *HasRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HasRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HasRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HasRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasRoleResponse.Merge(m, src)
+}
+func (m *HasRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HasRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasRoleResponse.DiscardUnknown(m)
 }
 
-func (this *SendETHRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_HasRoleResponse proto.InternalMessageInfo
+
+func (m *HasRoleResponse) GetHas() bool {
+	if m != nil {
+		return m.Has
 	}
+	return false
+}
 
-	that1, ok := that.(*SendETHRequest)
-	if !ok {
-		that2, ok := that.(SendETHRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ContainsWalletRequest struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Account         string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Block           string `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *ContainsWalletRequest) Reset()      { *m = ContainsWalletRequest{} }
+func (*ContainsWalletRequest) ProtoMessage() {}
+func (*ContainsWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{33}
+}
+func (m *ContainsWalletRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainsWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainsWalletRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PrivateKey != that1.PrivateKey {
-		return false
-	}
-	if this.Recipient != that1.Recipient {
-		return false
+}
+func (m *ContainsWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainsWalletRequest.Merge(m, src)
+}
+func (m *ContainsWalletRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainsWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainsWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainsWalletRequest proto.InternalMessageInfo
+
+func (m *ContainsWalletRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if this.Amount != that1.Amount {
-		return false
+	return ""
+}
+
+func (m *ContainsWalletRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return true
+	return ""
 }
-func (this *SendETHResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ContainsWalletRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
 	}
+	return ""
+}
 
-	that1, ok := that.(*SendETHResponse)
-	if !ok {
-		that2, ok := that.(SendETHResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ContainsWalletResponse struct {
+	Registered bool `protobuf:"varint,1,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (m *ContainsWalletResponse) Reset()      { *m = ContainsWalletResponse{} }
+func (*ContainsWalletResponse) ProtoMessage() {}
+func (*ContainsWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{34}
+}
+func (m *ContainsWalletResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainsWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainsWalletResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Hash != that1.Hash {
-		return false
-	}
-	return true
 }
-func (this *BalanceOfETHRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ContainsWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainsWalletResponse.Merge(m, src)
+}
+func (m *ContainsWalletResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainsWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainsWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainsWalletResponse proto.InternalMessageInfo
+
+func (m *ContainsWalletResponse) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
 	}
+	return false
+}
 
-	that1, ok := that.(*BalanceOfETHRequest)
-	if !ok {
-		that2, ok := that.(BalanceOfETHRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DeployFCRequest struct {
+	PrivateKey string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+}
+
+func (m *DeployFCRequest) Reset()      { *m = DeployFCRequest{} }
+func (*DeployFCRequest) ProtoMessage() {}
+func (*DeployFCRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{35}
+}
+func (m *DeployFCRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeployFCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeployFCRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Account != that1.Account {
-		return false
-	}
-	return true
 }
-func (this *BalanceOfETHResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DeployFCRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployFCRequest.Merge(m, src)
+}
+func (m *DeployFCRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeployFCRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployFCRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployFCRequest proto.InternalMessageInfo
+
+func (m *DeployFCRequest) GetPrivateKey() string {
+	if m != nil {
+		return m.PrivateKey
 	}
+	return ""
+}
 
-	that1, ok := that.(*BalanceOfETHResponse)
-	if !ok {
-		that2, ok := that.(BalanceOfETHResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DeployFCResponse struct {
+	Hash            string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *DeployFCResponse) Reset()      { *m = DeployFCResponse{} }
+func (*DeployFCResponse) ProtoMessage() {}
+func (*DeployFCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{36}
+}
+func (m *DeployFCResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeployFCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeployFCResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Amount != that1.Amount {
-		return false
-	}
-	return true
 }
-func (this *DeploySTRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DeployFCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployFCResponse.Merge(m, src)
+}
+func (m *DeployFCResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeployFCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployFCResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployFCResponse proto.InternalMessageInfo
+
+func (m *DeployFCResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
+	return ""
+}
 
-	that1, ok := that.(*DeploySTRequest)
-	if !ok {
-		that2, ok := that.(DeploySTRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *DeployFCResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+type CreateContractsRequest struct {
+	PrivateKey      string   `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	ContractAddress string   `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Name            string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol          string   `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	InitialSupply   string   `protobuf:"bytes,5,opt,name=initialSupply,proto3" json:"initialSupply,omitempty"`
+	Grantees        []string `protobuf:"bytes,6,rep,name=grantees,proto3" json:"grantees,omitempty"`
+}
+
+func (m *CreateContractsRequest) Reset()      { *m = CreateContractsRequest{} }
+func (*CreateContractsRequest) ProtoMessage() {}
+func (*CreateContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{37}
+}
+func (m *CreateContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.PrivateKey != that1.PrivateKey {
-		return false
+}
+func (m *CreateContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateContractsRequest.Merge(m, src)
+}
+func (m *CreateContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateContractsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateContractsRequest proto.InternalMessageInfo
+
+func (m *CreateContractsRequest) GetPrivateKey() string {
+	if m != nil {
+		return m.PrivateKey
 	}
-	if this.Name != that1.Name {
-		return false
+	return ""
+}
+
+func (m *CreateContractsRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if this.Symbol != that1.Symbol {
-		return false
+	return ""
+}
+
+func (m *CreateContractsRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.InitialSupply != that1.InitialSupply {
-		return false
+	return ""
+}
+
+func (m *CreateContractsRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	if this.ComplianceAddress != that1.ComplianceAddress {
-		return false
+	return ""
+}
+
+func (m *CreateContractsRequest) GetInitialSupply() string {
+	if m != nil {
+		return m.InitialSupply
 	}
-	return true
+	return ""
 }
-func (this *DeploySTResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CreateContractsRequest) GetGrantees() []string {
+	if m != nil {
+		return m.Grantees
 	}
+	return nil
+}
 
-	that1, ok := that.(*DeploySTResponse)
-	if !ok {
-		that2, ok := that.(DeploySTResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CreateContractsResponse struct {
+	Hash              string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ComplianceAddress string `protobuf:"bytes,2,opt,name=compliance_address,json=complianceAddress,proto3" json:"compliance_address,omitempty"`
+	TokenAddress      string `protobuf:"bytes,3,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+}
+
+func (m *CreateContractsResponse) Reset()      { *m = CreateContractsResponse{} }
+func (*CreateContractsResponse) ProtoMessage() {}
+func (*CreateContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{38}
+}
+func (m *CreateContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Hash != that1.Hash {
-		return false
+}
+func (m *CreateContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateContractsResponse.Merge(m, src)
+}
+func (m *CreateContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateContractsResponse proto.InternalMessageInfo
+
+func (m *CreateContractsResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
+	return ""
+}
+
+func (m *CreateContractsResponse) GetComplianceAddress() string {
+	if m != nil {
+		return m.ComplianceAddress
 	}
-	return true
+	return ""
 }
-func (this *IssueRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CreateContractsResponse) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
 	}
+	return ""
+}
 
-	that1, ok := that.(*IssueRequest)
-	if !ok {
-		that2, ok := that.(IssueRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListFactoryDeploymentsRequest struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Block           string `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	FromBlock       uint64 `protobuf:"varint,3,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+}
+
+func (m *ListFactoryDeploymentsRequest) Reset()      { *m = ListFactoryDeploymentsRequest{} }
+func (*ListFactoryDeploymentsRequest) ProtoMessage() {}
+func (*ListFactoryDeploymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{39}
+}
+func (m *ListFactoryDeploymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListFactoryDeploymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListFactoryDeploymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PrivateKey != that1.PrivateKey {
-		return false
-	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
-	}
-	if this.Recipient != that1.Recipient {
-		return false
-	}
-	if this.Amount != that1.Amount {
-		return false
-	}
-	if this.IsAsync != that1.IsAsync {
-		return false
-	}
-	if this.GasLimit != that1.GasLimit {
-		return false
+}
+func (m *ListFactoryDeploymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFactoryDeploymentsRequest.Merge(m, src)
+}
+func (m *ListFactoryDeploymentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListFactoryDeploymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFactoryDeploymentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFactoryDeploymentsRequest proto.InternalMessageInfo
+
+func (m *ListFactoryDeploymentsRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return true
+	return ""
 }
-func (this *IssueResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ListFactoryDeploymentsRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
 	}
+	return ""
+}
 
-	that1, ok := that.(*IssueResponse)
-	if !ok {
-		that2, ok := that.(IssueResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Hash != that1.Hash {
-		return false
+func (m *ListFactoryDeploymentsRequest) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
 	}
-	return true
+	return 0
 }
-func (this *RedeemRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*RedeemRequest)
-	if !ok {
-		that2, ok := that.(RedeemRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type FactoryDeployment struct {
+	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ComplianceAddress string `protobuf:"bytes,2,opt,name=compliance_address,json=complianceAddress,proto3" json:"compliance_address,omitempty"`
+	TokenAddress      string `protobuf:"bytes,3,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	Hash              string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	BlockNumber       uint64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Timestamp         uint64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Name              string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol            string `protobuf:"bytes,8,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TotalSupply       string `protobuf:"bytes,9,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	Paused            bool   `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *FactoryDeployment) Reset()      { *m = FactoryDeployment{} }
+func (*FactoryDeployment) ProtoMessage() {}
+func (*FactoryDeployment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{40}
+}
+func (m *FactoryDeployment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactoryDeployment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactoryDeployment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PrivateKey != that1.PrivateKey {
-		return false
+}
+func (m *FactoryDeployment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDeployment.Merge(m, src)
+}
+func (m *FactoryDeployment) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactoryDeployment) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDeployment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactoryDeployment proto.InternalMessageInfo
+
+func (m *FactoryDeployment) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
+	return ""
+}
+
+func (m *FactoryDeployment) GetComplianceAddress() string {
+	if m != nil {
+		return m.ComplianceAddress
 	}
-	if this.Account != that1.Account {
-		return false
+	return ""
+}
+
+func (m *FactoryDeployment) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
 	}
-	if this.Amount != that1.Amount {
-		return false
+	return ""
+}
+
+func (m *FactoryDeployment) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	if this.Reason != that1.Reason {
-		return false
+	return ""
+}
+
+func (m *FactoryDeployment) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
 	}
-	return true
+	return 0
 }
-func (this *RedeemResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *FactoryDeployment) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
 	}
+	return 0
+}
 
-	that1, ok := that.(*RedeemResponse)
-	if !ok {
-		that2, ok := that.(RedeemResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *FactoryDeployment) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *FactoryDeployment) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	if this.Hash != that1.Hash {
-		return false
+	return ""
+}
+
+func (m *FactoryDeployment) GetTotalSupply() string {
+	if m != nil {
+		return m.TotalSupply
 	}
-	return true
+	return ""
 }
-func (this *TransferRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *FactoryDeployment) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
+	return false
+}
 
-	that1, ok := that.(*TransferRequest)
-	if !ok {
-		that2, ok := that.(TransferRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListFactoryDeploymentsResponse struct {
+	Deployments []*FactoryDeployment `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
+}
+
+func (m *ListFactoryDeploymentsResponse) Reset()      { *m = ListFactoryDeploymentsResponse{} }
+func (*ListFactoryDeploymentsResponse) ProtoMessage() {}
+func (*ListFactoryDeploymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{41}
+}
+func (m *ListFactoryDeploymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListFactoryDeploymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListFactoryDeploymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PrivateKey != that1.PrivateKey {
-		return false
-	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
+}
+func (m *ListFactoryDeploymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFactoryDeploymentsResponse.Merge(m, src)
+}
+func (m *ListFactoryDeploymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListFactoryDeploymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFactoryDeploymentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFactoryDeploymentsResponse proto.InternalMessageInfo
+
+func (m *ListFactoryDeploymentsResponse) GetDeployments() []*FactoryDeployment {
+	if m != nil {
+		return m.Deployments
 	}
-	if this.Recipient != that1.Recipient {
-		return false
+	return nil
+}
+
+type OnboardingStep struct {
+	Name   string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status StepStatus `protobuf:"varint,2,opt,name=status,proto3,enum=angoya.stoserver.data.StepStatus" json:"status,omitempty"`
+	Hash   string     `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Error  string     `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *OnboardingStep) Reset()      { *m = OnboardingStep{} }
+func (*OnboardingStep) ProtoMessage() {}
+func (*OnboardingStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{42}
+}
+func (m *OnboardingStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnboardingStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OnboardingStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Amount != that1.Amount {
-		return false
+}
+func (m *OnboardingStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnboardingStep.Merge(m, src)
+}
+func (m *OnboardingStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnboardingStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnboardingStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnboardingStep proto.InternalMessageInfo
+
+func (m *OnboardingStep) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.IsAsync != that1.IsAsync {
-		return false
+	return ""
+}
+
+func (m *OnboardingStep) GetStatus() StepStatus {
+	if m != nil {
+		return m.Status
 	}
-	if this.GasLimit != that1.GasLimit {
-		return false
+	return StepStatus_STEP_PENDING
+}
+
+func (m *OnboardingStep) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return true
+	return ""
 }
-func (this *TransferResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *OnboardingStep) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*TransferResponse)
-	if !ok {
-		that2, ok := that.(TransferResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type OnboardInvestorRequest struct {
+	PrivateKey      string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Account         string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Amount          string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	IssueHash       string `protobuf:"bytes,5,opt,name=issue_hash,json=issueHash,proto3" json:"issue_hash,omitempty"`
+	GasLimit        uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *OnboardInvestorRequest) Reset()      { *m = OnboardInvestorRequest{} }
+func (*OnboardInvestorRequest) ProtoMessage() {}
+func (*OnboardInvestorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{43}
+}
+func (m *OnboardInvestorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnboardInvestorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OnboardInvestorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Hash != that1.Hash {
-		return false
-	}
-	return true
 }
-func (this *RegisterWalletRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OnboardInvestorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnboardInvestorRequest.Merge(m, src)
+}
+func (m *OnboardInvestorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnboardInvestorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnboardInvestorRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*RegisterWalletRequest)
+var xxx_messageInfo_OnboardInvestorRequest proto.InternalMessageInfo
+
+func (m *OnboardInvestorRequest) GetPrivateKey() string {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return ""
+}
+
+func (m *OnboardInvestorRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *OnboardInvestorRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *OnboardInvestorRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *OnboardInvestorRequest) GetIssueHash() string {
+	if m != nil {
+		return m.IssueHash
+	}
+	return ""
+}
+
+func (m *OnboardInvestorRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+type OnboardInvestorResponse struct {
+	ComplianceAddress string            `protobuf:"bytes,1,opt,name=compliance_address,json=complianceAddress,proto3" json:"compliance_address,omitempty"`
+	IssueHash         string            `protobuf:"bytes,2,opt,name=issue_hash,json=issueHash,proto3" json:"issue_hash,omitempty"`
+	Completed         bool              `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Steps             []*OnboardingStep `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (m *OnboardInvestorResponse) Reset()      { *m = OnboardInvestorResponse{} }
+func (*OnboardInvestorResponse) ProtoMessage() {}
+func (*OnboardInvestorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a3532adaf4834d5, []int{44}
+}
+func (m *OnboardInvestorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnboardInvestorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OnboardInvestorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OnboardInvestorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnboardInvestorResponse.Merge(m, src)
+}
+func (m *OnboardInvestorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnboardInvestorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnboardInvestorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnboardInvestorResponse proto.InternalMessageInfo
+
+func (m *OnboardInvestorResponse) GetComplianceAddress() string {
+	if m != nil {
+		return m.ComplianceAddress
+	}
+	return ""
+}
+
+func (m *OnboardInvestorResponse) GetIssueHash() string {
+	if m != nil {
+		return m.IssueHash
+	}
+	return ""
+}
+
+func (m *OnboardInvestorResponse) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *OnboardInvestorResponse) GetSteps() []*OnboardingStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("angoya.stoserver.data.RequestType", RequestType_name, RequestType_value)
+	proto.RegisterEnum("angoya.stoserver.data.StepStatus", StepStatus_name, StepStatus_value)
+	proto.RegisterType((*SendETHRequest)(nil), "angoya.stoserver.data.SendETHRequest")
+	proto.RegisterType((*SendETHResponse)(nil), "angoya.stoserver.data.SendETHResponse")
+	proto.RegisterType((*BalanceOfETHRequest)(nil), "angoya.stoserver.data.BalanceOfETHRequest")
+	proto.RegisterType((*BalanceOfETHResponse)(nil), "angoya.stoserver.data.BalanceOfETHResponse")
+	proto.RegisterType((*DeploySTRequest)(nil), "angoya.stoserver.data.DeploySTRequest")
+	proto.RegisterType((*DeploySTResponse)(nil), "angoya.stoserver.data.DeploySTResponse")
+	proto.RegisterType((*IssueRequest)(nil), "angoya.stoserver.data.IssueRequest")
+	proto.RegisterType((*IssueResponse)(nil), "angoya.stoserver.data.IssueResponse")
+	proto.RegisterType((*RedeemRequest)(nil), "angoya.stoserver.data.RedeemRequest")
+	proto.RegisterType((*RedeemResponse)(nil), "angoya.stoserver.data.RedeemResponse")
+	proto.RegisterType((*TransferRequest)(nil), "angoya.stoserver.data.TransferRequest")
+	proto.RegisterType((*TransferResponse)(nil), "angoya.stoserver.data.TransferResponse")
+	proto.RegisterType((*RegisterWalletRequest)(nil), "angoya.stoserver.data.RegisterWalletRequest")
+	proto.RegisterType((*RegisterWalletResponse)(nil), "angoya.stoserver.data.RegisterWalletResponse")
+	proto.RegisterType((*NameRequest)(nil), "angoya.stoserver.data.NameRequest")
+	proto.RegisterType((*NameResponse)(nil), "angoya.stoserver.data.NameResponse")
+	proto.RegisterType((*SymbolRequest)(nil), "angoya.stoserver.data.SymbolRequest")
+	proto.RegisterType((*SymbolResponse)(nil), "angoya.stoserver.data.SymbolResponse")
+	proto.RegisterType((*TotalSupplyRequest)(nil), "angoya.stoserver.data.TotalSupplyRequest")
+	proto.RegisterType((*TotalSupplyResponse)(nil), "angoya.stoserver.data.TotalSupplyResponse")
+	proto.RegisterType((*BalanceOfRequest)(nil), "angoya.stoserver.data.BalanceOfRequest")
+	proto.RegisterType((*BalanceOfResponse)(nil), "angoya.stoserver.data.BalanceOfResponse")
+	proto.RegisterType((*BatchBalanceOfRequest)(nil), "angoya.stoserver.data.BatchBalanceOfRequest")
+	proto.RegisterType((*AccountBalance)(nil), "angoya.stoserver.data.AccountBalance")
+	proto.RegisterType((*BatchBalanceOfResponse)(nil), "angoya.stoserver.data.BatchBalanceOfResponse")
+	proto.RegisterType((*TokenInfoRequest)(nil), "angoya.stoserver.data.TokenInfoRequest")
+	proto.RegisterType((*TokenInfoResponse)(nil), "angoya.stoserver.data.TokenInfoResponse")
+	proto.RegisterType((*DeployCSRequest)(nil), "angoya.stoserver.data.DeployCSRequest")
+	proto.RegisterType((*DeployCSResponse)(nil), "angoya.stoserver.data.DeployCSResponse")
+	proto.RegisterType((*GrantRoleRequest)(nil), "angoya.stoserver.data.GrantRoleRequest")
+	proto.RegisterType((*GrantRoleResponse)(nil), "angoya.stoserver.data.GrantRoleResponse")
+	proto.RegisterType((*HasRoleRequest)(nil), "angoya.stoserver.data.HasRoleRequest")
+	proto.RegisterType((*HasRoleResponse)(nil), "angoya.stoserver.data.HasRoleResponse")
+	proto.RegisterType((*ContainsWalletRequest)(nil), "angoya.stoserver.data.ContainsWalletRequest")
+	proto.RegisterType((*ContainsWalletResponse)(nil), "angoya.stoserver.data.ContainsWalletResponse")
+	proto.RegisterType((*DeployFCRequest)(nil), "angoya.stoserver.data.DeployFCRequest")
+	proto.RegisterType((*DeployFCResponse)(nil), "angoya.stoserver.data.DeployFCResponse")
+	proto.RegisterType((*CreateContractsRequest)(nil), "angoya.stoserver.data.CreateContractsRequest")
+	proto.RegisterType((*CreateContractsResponse)(nil), "angoya.stoserver.data.CreateContractsResponse")
+	proto.RegisterType((*ListFactoryDeploymentsRequest)(nil), "angoya.stoserver.data.ListFactoryDeploymentsRequest")
+	proto.RegisterType((*FactoryDeployment)(nil), "angoya.stoserver.data.FactoryDeployment")
+	proto.RegisterType((*ListFactoryDeploymentsResponse)(nil), "angoya.stoserver.data.ListFactoryDeploymentsResponse")
+	proto.RegisterType((*OnboardingStep)(nil), "angoya.stoserver.data.OnboardingStep")
+	proto.RegisterType((*OnboardInvestorRequest)(nil), "angoya.stoserver.data.OnboardInvestorRequest")
+	proto.RegisterType((*OnboardInvestorResponse)(nil), "angoya.stoserver.data.OnboardInvestorResponse")
+}
+
+func init() { proto.RegisterFile("security-token.proto", fileDescriptor_0a3532adaf4834d5) }
+
+var fileDescriptor_0a3532adaf4834d5 = []byte{
+	// 1772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0xdb, 0xc8,
+	0xf5, 0x37, 0x25, 0xd9, 0x91, 0x9e, 0x7e, 0xd1, 0x13, 0xdb, 0xd1, 0x37, 0xdf, 0x44, 0x9b, 0x30,
+	0x49, 0xd7, 0x5d, 0x34, 0x0e, 0x90, 0xf6, 0xd0, 0xa2, 0x27, 0x5a, 0xa2, 0x62, 0x35, 0x0a, 0xa5,
+	0x92, 0xcc, 0x2e, 0xdc, 0x0b, 0x31, 0xa6, 0xc6, 0x32, 0x11, 0x89, 0x54, 0x39, 0xa3, 0x2c, 0x74,
+	0xda, 0x16, 0x3d, 0x15, 0xe8, 0xa1, 0xc7, 0xfe, 0x03, 0x05, 0x7a, 0x2f, 0x7a, 0x2c, 0x50, 0xf4,
+	0xb4, 0x40, 0x2f, 0x8b, 0x9e, 0xf6, 0xd8, 0x75, 0xfe, 0x81, 0xf6, 0x3f, 0x28, 0x66, 0x38, 0x12,
+	0x29, 0x59, 0xd2, 0x66, 0x9b, 0x78, 0xd1, 0xde, 0x38, 0x6f, 0x1e, 0xdf, 0xfb, 0x7c, 0xde, 0xbc,
+	0x79, 0xef, 0x91, 0xb0, 0x47, 0x89, 0x37, 0x89, 0x7c, 0x36, 0x7d, 0xcc, 0xc2, 0x57, 0x24, 0x38,
+	0x1a, 0x47, 0x21, 0x0b, 0xd1, 0x3e, 0x0e, 0x06, 0xe1, 0x14, 0x1f, 0x51, 0x16, 0x52, 0x12, 0xbd,
+	0x26, 0xd1, 0x51, 0x1f, 0x33, 0x7c, 0x7b, 0x6f, 0x10, 0x0e, 0x42, 0xa1, 0xf1, 0x84, 0x3f, 0xc5,
+	0xca, 0xda, 0x00, 0x2a, 0x36, 0x09, 0xfa, 0x86, 0x73, 0x62, 0x91, 0x9f, 0x4f, 0x08, 0x65, 0xe8,
+	0x03, 0x28, 0x8e, 0x23, 0xff, 0x35, 0x66, 0xc4, 0x7d, 0x45, 0xa6, 0x35, 0xe5, 0x9e, 0x72, 0x58,
+	0xb0, 0x40, 0x8a, 0x9e, 0x93, 0x29, 0xba, 0x03, 0x85, 0x88, 0x78, 0xfe, 0xd8, 0x27, 0x01, 0xab,
+	0x65, 0xc4, 0x76, 0x22, 0x40, 0x07, 0xb0, 0x83, 0x47, 0xe1, 0x24, 0x60, 0xb5, 0xac, 0xd8, 0x92,
+	0x2b, 0xed, 0x11, 0x54, 0xe7, 0x8e, 0xe8, 0x38, 0x0c, 0x28, 0x41, 0x08, 0x72, 0x17, 0x98, 0x5e,
+	0x48, 0x17, 0xe2, 0x59, 0x33, 0xe0, 0xe6, 0x31, 0x1e, 0xe2, 0xc0, 0x23, 0xdd, 0xf3, 0x14, 0xa8,
+	0x1a, 0xdc, 0xc0, 0x9e, 0x27, 0xcc, 0xc6, 0xda, 0xb3, 0x25, 0xda, 0x83, 0xed, 0xb3, 0x61, 0xe8,
+	0xbd, 0x92, 0x48, 0xe2, 0x85, 0x76, 0x0a, 0x7b, 0x8b, 0x66, 0xa4, 0xcb, 0x04, 0x9d, 0x92, 0x46,
+	0x87, 0xbe, 0x0b, 0xea, 0x79, 0x18, 0x8d, 0x30, 0x63, 0xa4, 0xef, 0x4a, 0x8d, 0xd8, 0x60, 0x75,
+	0x2e, 0xd7, 0x63, 0x22, 0x7f, 0x54, 0xa0, 0xda, 0x24, 0xe3, 0x61, 0x38, 0xb5, 0x9d, 0xb7, 0x8e,
+	0x19, 0x82, 0x5c, 0x80, 0x47, 0x44, 0xda, 0x14, 0xcf, 0x1c, 0x0b, 0x9d, 0x8e, 0xce, 0xc2, 0xe1,
+	0x2c, 0x52, 0xf1, 0x0a, 0x3d, 0x84, 0xb2, 0x1f, 0xf8, 0xcc, 0xc7, 0x43, 0x7b, 0x32, 0x1e, 0x0f,
+	0xa7, 0xb5, 0x9c, 0xd8, 0x5e, 0x14, 0xa2, 0xc7, 0x80, 0xbc, 0x70, 0x34, 0x1e, 0xfa, 0x9c, 0xa4,
+	0x8b, 0xfb, 0xfd, 0x88, 0x50, 0x5a, 0xdb, 0x16, 0xaa, 0xbb, 0xc9, 0x8e, 0x1e, 0x6f, 0x68, 0x3f,
+	0x05, 0x35, 0x01, 0xbd, 0x3e, 0xfe, 0x3c, 0x10, 0x5e, 0x18, 0xb0, 0x08, 0x7b, 0x6c, 0x6e, 0x54,
+	0x06, 0x62, 0x26, 0x9f, 0x99, 0xfc, 0x5c, 0x81, 0x52, 0x9b, 0xd2, 0x09, 0x79, 0xeb, 0x28, 0xbc,
+	0xbd, 0xf1, 0xc5, 0x24, 0xcb, 0xae, 0x4f, 0xb2, 0xdc, 0xc2, 0x31, 0xfe, 0x1f, 0xe4, 0x7d, 0xea,
+	0x62, 0x3a, 0x0d, 0x3c, 0x11, 0x8a, 0xbc, 0x75, 0xc3, 0xa7, 0x3a, 0x5f, 0xa2, 0xff, 0x87, 0xc2,
+	0x00, 0x53, 0x77, 0xe8, 0x8f, 0x7c, 0x56, 0xdb, 0xb9, 0xa7, 0x1c, 0xe6, 0xac, 0xfc, 0x00, 0xd3,
+	0x0e, 0x5f, 0x6b, 0x0f, 0xa0, 0x2c, 0x99, 0x6c, 0x48, 0xcd, 0xdf, 0x2b, 0x50, 0xb6, 0x48, 0x9f,
+	0x90, 0xd1, 0x75, 0x10, 0x4e, 0x65, 0x78, 0x76, 0x31, 0xc3, 0xd7, 0x91, 0x3d, 0x80, 0x9d, 0x88,
+	0x60, 0x1a, 0x06, 0xf2, 0xd4, 0xe5, 0x4a, 0x7b, 0x08, 0x95, 0x19, 0xcc, 0x0d, 0x6c, 0xfe, 0xa6,
+	0x40, 0xd5, 0x89, 0x70, 0x40, 0xcf, 0x49, 0xf4, 0xbf, 0x7f, 0x80, 0xdf, 0x01, 0x35, 0x21, 0xb3,
+	0x81, 0xf5, 0x9f, 0x14, 0xd8, 0xb7, 0xc8, 0xc0, 0xa7, 0x8c, 0x44, 0x9f, 0xe0, 0xe1, 0x90, 0xb0,
+	0x6f, 0xf7, 0x2c, 0xd3, 0xfc, 0x72, 0x1b, 0xf8, 0x6d, 0x2f, 0xf1, 0xfb, 0x1e, 0x1c, 0x2c, 0xc3,
+	0xde, 0xc0, 0xd2, 0x84, 0xa2, 0x89, 0x47, 0xf3, 0x7b, 0xb9, 0x0a, 0xb9, 0xb2, 0x1a, 0xf9, 0xea,
+	0x6a, 0xaa, 0x41, 0x29, 0xb6, 0x97, 0xf8, 0x14, 0xd5, 0x4c, 0x49, 0xaa, 0x99, 0xd6, 0x83, 0xb2,
+	0x2d, 0xea, 0xd7, 0x7b, 0xf3, 0x7a, 0x08, 0x95, 0x99, 0xc5, 0xa4, 0x7a, 0xcb, 0x8a, 0xa9, 0xa4,
+	0x2b, 0xa6, 0xf6, 0x12, 0x90, 0x13, 0xb2, 0x59, 0x69, 0x7c, 0x6f, 0x00, 0x18, 0xdc, 0x5c, 0x30,
+	0xfb, 0x35, 0x3d, 0xe4, 0x36, 0xe4, 0xfb, 0xc4, 0xf3, 0x47, 0x78, 0x18, 0x27, 0x46, 0xd9, 0x9a,
+	0xaf, 0x57, 0xf6, 0x97, 0xec, 0xea, 0xfe, 0x32, 0x02, 0x75, 0xde, 0xba, 0xfe, 0x03, 0x2a, 0xa9,
+	0xdc, 0xcb, 0xac, 0xe9, 0x94, 0xd9, 0x34, 0xc9, 0x08, 0x76, 0x53, 0xee, 0xbe, 0x1d, 0x8a, 0x0c,
+	0xf6, 0x8f, 0x31, 0xf3, 0x2e, 0xde, 0x85, 0xe7, 0x6d, 0xc8, 0x4b, 0x62, 0x1c, 0x4a, 0xf6, 0xb0,
+	0x60, 0xcd, 0xd7, 0x6b, 0x98, 0xfe, 0x4a, 0x81, 0x8a, 0x1e, 0xab, 0x48, 0xc7, 0x1b, 0xc6, 0x8a,
+	0x24, 0x02, 0x99, 0xaf, 0x1d, 0x14, 0x56, 0xb3, 0xe4, 0x28, 0x48, 0x14, 0x85, 0x91, 0x2c, 0x71,
+	0xf1, 0x42, 0xfb, 0x14, 0x0e, 0x96, 0xb9, 0xcb, 0xa0, 0xa7, 0x83, 0xab, 0x2c, 0x05, 0x57, 0x87,
+	0xfc, 0x59, 0xfc, 0x42, 0xcc, 0xb6, 0xf8, 0xf4, 0xd1, 0xd1, 0xca, 0x31, 0xef, 0x68, 0x91, 0xa1,
+	0x35, 0x7f, 0x4d, 0xb3, 0x41, 0x75, 0xf8, 0x94, 0xd8, 0x0e, 0xce, 0xc3, 0xf7, 0x76, 0x45, 0xfe,
+	0x92, 0x85, 0xdd, 0x94, 0xd5, 0xf5, 0xf5, 0x21, 0x75, 0x77, 0x33, 0x0b, 0xd3, 0x4e, 0x9a, 0x75,
+	0x76, 0x89, 0xf5, 0x7d, 0x28, 0x31, 0x7e, 0x01, 0x5d, 0x9a, 0x1e, 0x84, 0x8a, 0x2c, 0xb9, 0x94,
+	0xe8, 0x07, 0x70, 0x90, 0x9c, 0xc7, 0x82, 0x72, 0xdc, 0x14, 0xf7, 0xe6, 0xbb, 0xa9, 0xab, 0xbc,
+	0x66, 0x78, 0xda, 0x59, 0x33, 0x3c, 0x2d, 0xa9, 0xbf, 0x26, 0x11, 0xf5, 0xc3, 0xa0, 0x76, 0x43,
+	0xa0, 0x4d, 0xa9, 0x7f, 0x1c, 0x6f, 0xa0, 0x47, 0x50, 0xe9, 0x87, 0xde, 0x64, 0x44, 0x02, 0xe6,
+	0xc6, 0xc9, 0x95, 0x17, 0xe5, 0xbc, 0x3c, 0x93, 0x36, 0x66, 0x29, 0x36, 0xc6, 0x13, 0x4a, 0xfa,
+	0xb5, 0x82, 0xe8, 0x04, 0x72, 0x85, 0x3e, 0x84, 0x2a, 0x93, 0xbd, 0xcc, 0x95, 0x0a, 0x20, 0x14,
+	0x2a, 0x33, 0x71, 0x2f, 0x56, 0xbc, 0x0f, 0xa5, 0x4f, 0x45, 0x33, 0x90, 0x5e, 0x8a, 0xc2, 0x4b,
+	0x31, 0x96, 0xc5, 0x3e, 0xee, 0x43, 0x49, 0x1c, 0x94, 0x1b, 0x4c, 0x46, 0x67, 0x24, 0xaa, 0x95,
+	0x62, 0x15, 0x21, 0x33, 0x85, 0x48, 0x7b, 0x3a, 0x1b, 0x67, 0x1b, 0xf6, 0xdb, 0xf6, 0xc2, 0x64,
+	0x9a, 0x6c, 0xd8, 0xe9, 0x43, 0x7f, 0x97, 0x69, 0xf2, 0x37, 0x0a, 0xa8, 0xcf, 0x22, 0x1c, 0x30,
+	0x2b, 0x1c, 0x5e, 0xcb, 0x44, 0x89, 0x20, 0x17, 0x85, 0x43, 0x22, 0x6f, 0xab, 0x78, 0xe6, 0xf7,
+	0x7f, 0xc0, 0x7d, 0x12, 0x22, 0x73, 0x6b, 0xb6, 0xd4, 0x3e, 0x84, 0xdd, 0x14, 0x9a, 0x0d, 0xbd,
+	0xf6, 0x33, 0xa8, 0x9c, 0x60, 0x9a, 0x06, 0xfd, 0x0d, 0x2e, 0xd5, 0x0c, 0x53, 0x66, 0x11, 0xd3,
+	0x9a, 0xe1, 0x61, 0x7e, 0x05, 0x73, 0xe9, 0x2b, 0xf8, 0x00, 0xaa, 0x73, 0x00, 0x12, 0xa7, 0x0a,
+	0xd9, 0x0b, 0x1c, 0x3b, 0xcd, 0x5b, 0xfc, 0x51, 0x8b, 0x60, 0xbf, 0x11, 0x06, 0x0c, 0xfb, 0x01,
+	0x5d, 0x1c, 0x7b, 0xae, 0xb1, 0xb3, 0xfc, 0x10, 0x0e, 0x96, 0x7d, 0x4a, 0x7c, 0x75, 0x80, 0x48,
+	0x4e, 0x33, 0xa4, 0x2f, 0x61, 0xa6, 0x24, 0x49, 0x4a, 0xb6, 0x1a, 0xdf, 0x3c, 0x25, 0x5b, 0x8d,
+	0xb9, 0x9f, 0x77, 0x4c, 0xc9, 0xbf, 0x2b, 0x70, 0xd0, 0x88, 0x08, 0x66, 0xa4, 0x21, 0x77, 0xe8,
+	0x35, 0x25, 0xa6, 0xa8, 0x96, 0xd9, 0x95, 0xd5, 0x32, 0xb7, 0xf9, 0xdb, 0x70, 0x7b, 0xd5, 0xb7,
+	0xe1, 0x6d, 0xc8, 0xcb, 0x3c, 0xe6, 0x45, 0x4d, 0xf4, 0xc6, 0xd9, 0x5a, 0xfb, 0xa5, 0x02, 0xb7,
+	0xae, 0x90, 0xda, 0x10, 0xaf, 0xd5, 0xa5, 0x32, 0xb3, 0xae, 0x54, 0x3e, 0x80, 0xb2, 0xf8, 0x17,
+	0x31, 0xd7, 0x8c, 0x59, 0x95, 0x84, 0x70, 0x16, 0xd8, 0xcf, 0xe0, 0x6e, 0xc7, 0xa7, 0xac, 0x85,
+	0x3d, 0x16, 0x46, 0xd3, 0xf8, 0xd8, 0x78, 0x5d, 0xa4, 0xef, 0xab, 0x2f, 0xa1, 0xbb, 0x00, 0xe7,
+	0x51, 0x38, 0x72, 0x93, 0xb4, 0xcc, 0x59, 0x05, 0x2e, 0x39, 0x16, 0xa9, 0xf9, 0xe7, 0x0c, 0xec,
+	0x5e, 0xf1, 0xce, 0x13, 0xdc, 0xe3, 0x91, 0x09, 0xa3, 0xd9, 0x34, 0x20, 0x97, 0xd7, 0x11, 0x84,
+	0x79, 0xb0, 0x73, 0xa9, 0x60, 0x2f, 0x97, 0xeb, 0xed, 0x2b, 0xe5, 0x9a, 0x7f, 0x57, 0x31, 0x7f,
+	0x44, 0x28, 0xc3, 0xa3, 0xb1, 0xfc, 0x0c, 0x4a, 0x04, 0xf3, 0x5c, 0xba, 0xb1, 0x32, 0x97, 0xf2,
+	0x0b, 0xb9, 0xb4, 0xdc, 0x5d, 0x0b, 0x57, 0xbb, 0x6b, 0xd2, 0xa2, 0x20, 0xdd, 0xa2, 0xb4, 0x21,
+	0xd4, 0xd7, 0x1d, 0xa0, 0x4c, 0xa5, 0x9f, 0x40, 0xb1, 0x9f, 0x88, 0x6b, 0x8a, 0x98, 0x59, 0x0e,
+	0xd7, 0xcc, 0x2c, 0x57, 0xec, 0x58, 0xe9, 0x97, 0xb5, 0x5f, 0x2b, 0x50, 0xe9, 0x06, 0x67, 0x21,
+	0x8e, 0xfa, 0x7e, 0x30, 0xb0, 0x19, 0x19, 0xaf, 0x9c, 0x30, 0x7e, 0x04, 0x3b, 0x94, 0x61, 0x36,
+	0x89, 0x0f, 0xa6, 0xf2, 0xf4, 0xfe, 0x1a, 0x6f, 0xdc, 0x80, 0x2d, 0x14, 0x2d, 0xf9, 0xc2, 0xfc,
+	0x2c, 0xb2, 0xa9, 0xb3, 0x58, 0x3d, 0xbe, 0xf1, 0x9a, 0x20, 0xb1, 0xb4, 0x83, 0xd7, 0x84, 0xb2,
+	0x30, 0xfa, 0xef, 0xf8, 0x1b, 0x70, 0x17, 0xc0, 0xa7, 0x74, 0x42, 0x5c, 0x41, 0x24, 0x2e, 0x0b,
+	0x05, 0x21, 0x39, 0xe1, 0x6c, 0x36, 0x7e, 0x3d, 0xff, 0x55, 0x81, 0x5b, 0x57, 0x48, 0xc9, 0x83,
+	0x5c, 0x9d, 0xfa, 0xca, 0xba, 0xd4, 0x5f, 0x84, 0x91, 0x59, 0x86, 0x71, 0x07, 0x0a, 0xe2, 0x1d,
+	0xc2, 0x48, 0x5f, 0x30, 0xcb, 0x5b, 0x89, 0x00, 0xfd, 0x18, 0xb6, 0x29, 0x23, 0x63, 0x5a, 0xcb,
+	0x6d, 0x1c, 0x71, 0x17, 0x73, 0xc1, 0x8a, 0xdf, 0xf9, 0xe8, 0x5f, 0x19, 0x28, 0xca, 0xa3, 0x70,
+	0xa6, 0x63, 0x82, 0x4a, 0x90, 0xb7, 0x0d, 0xb3, 0xe9, 0x1a, 0xce, 0x89, 0xba, 0x85, 0x10, 0x54,
+	0x8e, 0xf5, 0x8e, 0x6e, 0x36, 0x0c, 0xb7, 0xdb, 0x12, 0x32, 0x05, 0x95, 0xa1, 0xd0, 0x34, 0x7a,
+	0x9d, 0xee, 0xa9, 0x6b, 0x3b, 0x2a, 0xa0, 0x02, 0x6c, 0xb7, 0x6d, 0xfb, 0xa5, 0xa1, 0x16, 0x11,
+	0xc0, 0x8e, 0x65, 0x34, 0x0d, 0xe3, 0x85, 0x5a, 0xe2, 0x76, 0x1c, 0x4b, 0x37, 0xed, 0x96, 0x61,
+	0xa9, 0x65, 0x74, 0x13, 0xaa, 0x96, 0xf1, 0xac, 0x6d, 0x3b, 0x86, 0xe5, 0x7e, 0xa2, 0x77, 0x3a,
+	0x86, 0xa3, 0x56, 0x90, 0x0a, 0x25, 0xa7, 0xeb, 0xe8, 0x1d, 0xd7, 0x7e, 0xd9, 0xeb, 0x75, 0x4e,
+	0xd5, 0x2a, 0xaa, 0x00, 0x24, 0xee, 0x54, 0x15, 0xe5, 0x21, 0x67, 0xea, 0x2f, 0x0c, 0x75, 0x97,
+	0x9b, 0xb6, 0x4f, 0x5f, 0x1c, 0x77, 0x3b, 0x2a, 0x42, 0x7b, 0xa0, 0x1e, 0xeb, 0x4e, 0xe3, 0xc4,
+	0x4d, 0xe9, 0xde, 0x4c, 0xc1, 0x6a, 0xd8, 0xea, 0x1e, 0x37, 0xf5, 0xcc, 0xd2, 0x4d, 0xc7, 0xb5,
+	0xba, 0x1d, 0x43, 0xdd, 0xe7, 0x78, 0x4e, 0x74, 0x3b, 0x5e, 0x1d, 0x70, 0x3c, 0x8d, 0xae, 0xe9,
+	0xe8, 0x6d, 0xd3, 0x9e, 0xe1, 0xb9, 0x95, 0xb2, 0xd0, 0x6a, 0xa8, 0x75, 0xee, 0xa6, 0x61, 0x19,
+	0xba, 0x63, 0xb8, 0x5c, 0xd5, 0xd2, 0x1b, 0x8e, 0xad, 0x7e, 0x80, 0xee, 0x40, 0xad, 0xd3, 0xb6,
+	0x1d, 0xb7, 0xa5, 0x37, 0x9c, 0xae, 0x75, 0xea, 0xc6, 0x6f, 0xbc, 0x30, 0x4c, 0xc7, 0x56, 0xef,
+	0x71, 0xaf, 0x4e, 0xf7, 0xb9, 0x61, 0xba, 0x6d, 0xb3, 0xd5, 0x55, 0x0f, 0xb9, 0x8d, 0xae, 0x79,
+	0xdc, 0xd5, 0xad, 0xa6, 0xdb, 0x36, 0x3f, 0x36, 0x6c, 0xa7, 0x6b, 0xa9, 0x4f, 0x3f, 0xea, 0x01,
+	0x24, 0xb7, 0x89, 0x87, 0xc1, 0x76, 0x8c, 0x9e, 0xdb, 0x33, 0xcc, 0x66, 0xdb, 0x7c, 0xa6, 0x6e,
+	0x71, 0x20, 0x42, 0xd2, 0xec, 0x9a, 0x86, 0xaa, 0xcc, 0x15, 0xec, 0xe7, 0xed, 0x5e, 0xcf, 0x68,
+	0xaa, 0x19, 0x54, 0x85, 0xa2, 0x90, 0xb4, 0xf4, 0x76, 0xc7, 0x68, 0xaa, 0xd9, 0x63, 0xeb, 0xcb,
+	0xaf, 0xea, 0x5b, 0xff, 0xfc, 0xaa, 0xae, 0xfc, 0xe2, 0xb2, 0xae, 0xfc, 0xe1, 0xb2, 0xae, 0x7c,
+	0x7e, 0x59, 0x57, 0xbe, 0xb8, 0xac, 0x2b, 0xff, 0xb8, 0xac, 0x2b, 0xbf, 0x7d, 0x53, 0xdf, 0xfa,
+	0xdd, 0x9b, 0xfa, 0xd6, 0x17, 0x6f, 0xea, 0x5b, 0x5f, 0xbe, 0xa9, 0x6f, 0xfd, 0xec, 0xe1, 0xc0,
+	0x67, 0x17, 0x93, 0xb3, 0x23, 0x2f, 0x1c, 0x3d, 0xe1, 0xb9, 0xf2, 0x78, 0x8a, 0x9f, 0x78, 0x17,
+	0xd8, 0x0f, 0x1e, 0x7b, 0x43, 0xfe, 0x9f, 0xe9, 0x09, 0xcf, 0x97, 0xb3, 0x1d, 0xf1, 0xab, 0xfb,
+	0xfb, 0xff, 0x1e, 0x00, 0xd4, 0xf1, 0xa6, 0xff, 0x2f, 0x17, 0x00, 0x00,
+}
+
+func (this *SendETHRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendETHRequest)
 	if !ok {
-		that2, ok := that.(RegisterWalletRequest)
+		that2, ok := that.(SendETHRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2266,28 +3014,22 @@ func (this *RegisterWalletRequest) Equal(that interface{}) bool {
 	if this.PrivateKey != that1.PrivateKey {
 		return false
 	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
-	}
-	if this.Account != that1.Account {
-		return false
-	}
-	if this.IsAsync != that1.IsAsync {
+	if this.Recipient != that1.Recipient {
 		return false
 	}
-	if this.GasLimit != that1.GasLimit {
+	if this.Amount != that1.Amount {
 		return false
 	}
 	return true
 }
-func (this *RegisterWalletResponse) Equal(that interface{}) bool {
+func (this *SendETHResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RegisterWalletResponse)
+	that1, ok := that.(*SendETHResponse)
 	if !ok {
-		that2, ok := that.(RegisterWalletResponse)
+		that2, ok := that.(SendETHResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2304,14 +3046,14 @@ func (this *RegisterWalletResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *NameRequest) Equal(that interface{}) bool {
+func (this *BalanceOfETHRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*NameRequest)
+	that1, ok := that.(*BalanceOfETHRequest)
 	if !ok {
-		that2, ok := that.(NameRequest)
+		that2, ok := that.(BalanceOfETHRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2323,19 +3065,22 @@ func (this *NameRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ContractAddress != that1.ContractAddress {
+	if this.Account != that1.Account {
+		return false
+	}
+	if this.Block != that1.Block {
 		return false
 	}
 	return true
 }
-func (this *NameResponse) Equal(that interface{}) bool {
+func (this *BalanceOfETHResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*NameResponse)
+	that1, ok := that.(*BalanceOfETHResponse)
 	if !ok {
-		that2, ok := that.(NameResponse)
+		that2, ok := that.(BalanceOfETHResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2347,19 +3092,22 @@ func (this *NameResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.FormattedAmount != that1.FormattedAmount {
 		return false
 	}
 	return true
 }
-func (this *SymbolRequest) Equal(that interface{}) bool {
+func (this *DeploySTRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SymbolRequest)
+	that1, ok := that.(*DeploySTRequest)
 	if !ok {
-		that2, ok := that.(SymbolRequest)
+		that2, ok := that.(DeploySTRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2371,19 +3119,31 @@ func (this *SymbolRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ContractAddress != that1.ContractAddress {
+	if this.PrivateKey != that1.PrivateKey {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.InitialSupply != that1.InitialSupply {
+		return false
+	}
+	if this.ComplianceAddress != that1.ComplianceAddress {
 		return false
 	}
 	return true
 }
-func (this *SymbolResponse) Equal(that interface{}) bool {
+func (this *DeploySTResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SymbolResponse)
+	that1, ok := that.(*DeploySTResponse)
 	if !ok {
-		that2, ok := that.(SymbolResponse)
+		that2, ok := that.(DeploySTResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2395,19 +3155,22 @@ func (this *SymbolResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Symbol != that1.Symbol {
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
 		return false
 	}
 	return true
 }
-func (this *TotalSupplyRequest) Equal(that interface{}) bool {
+func (this *IssueRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TotalSupplyRequest)
+	that1, ok := that.(*IssueRequest)
 	if !ok {
-		that2, ok := that.(TotalSupplyRequest)
+		that2, ok := that.(IssueRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2419,19 +3182,34 @@ func (this *TotalSupplyRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.PrivateKey != that1.PrivateKey {
+		return false
+	}
 	if this.ContractAddress != that1.ContractAddress {
 		return false
 	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.IsAsync != that1.IsAsync {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
 	return true
 }
-func (this *TotalSupplyResponse) Equal(that interface{}) bool {
+func (this *IssueResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TotalSupplyResponse)
+	that1, ok := that.(*IssueResponse)
 	if !ok {
-		that2, ok := that.(TotalSupplyResponse)
+		that2, ok := that.(IssueResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2443,19 +3221,19 @@ func (this *TotalSupplyResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Amount != that1.Amount {
+	if this.Hash != that1.Hash {
 		return false
 	}
 	return true
 }
-func (this *BalanceOfRequest) Equal(that interface{}) bool {
+func (this *RedeemRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*BalanceOfRequest)
+	that1, ok := that.(*RedeemRequest)
 	if !ok {
-		that2, ok := that.(BalanceOfRequest)
+		that2, ok := that.(RedeemRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2467,22 +3245,31 @@ func (this *BalanceOfRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.PrivateKey != that1.PrivateKey {
+		return false
+	}
 	if this.ContractAddress != that1.ContractAddress {
 		return false
 	}
 	if this.Account != that1.Account {
 		return false
 	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
 	return true
 }
-func (this *BalanceOfResponse) Equal(that interface{}) bool {
+func (this *RedeemResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*BalanceOfResponse)
+	that1, ok := that.(*RedeemResponse)
 	if !ok {
-		that2, ok := that.(BalanceOfResponse)
+		that2, ok := that.(RedeemResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2494,19 +3281,19 @@ func (this *BalanceOfResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Amount != that1.Amount {
+	if this.Hash != that1.Hash {
 		return false
 	}
 	return true
 }
-func (this *DeployCSRequest) Equal(that interface{}) bool {
+func (this *TransferRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeployCSRequest)
+	that1, ok := that.(*TransferRequest)
 	if !ok {
-		that2, ok := that.(DeployCSRequest)
+		that2, ok := that.(TransferRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2521,16 +3308,31 @@ func (this *DeployCSRequest) Equal(that interface{}) bool {
 	if this.PrivateKey != that1.PrivateKey {
 		return false
 	}
-	return true
-}
-func (this *DeployCSResponse) Equal(that interface{}) bool {
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.IsAsync != that1.IsAsync {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+func (this *TransferResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeployCSResponse)
+	that1, ok := that.(*TransferResponse)
 	if !ok {
-		that2, ok := that.(DeployCSResponse)
+		that2, ok := that.(TransferResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2545,19 +3347,16 @@ func (this *DeployCSResponse) Equal(that interface{}) bool {
 	if this.Hash != that1.Hash {
 		return false
 	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
-	}
 	return true
 }
-func (this *GrantRoleRequest) Equal(that interface{}) bool {
+func (this *RegisterWalletRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GrantRoleRequest)
+	that1, ok := that.(*RegisterWalletRequest)
 	if !ok {
-		that2, ok := that.(GrantRoleRequest)
+		that2, ok := that.(RegisterWalletRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2575,22 +3374,25 @@ func (this *GrantRoleRequest) Equal(that interface{}) bool {
 	if this.ContractAddress != that1.ContractAddress {
 		return false
 	}
-	if this.Role != that1.Role {
+	if this.Account != that1.Account {
 		return false
 	}
-	if this.Grantee != that1.Grantee {
+	if this.IsAsync != that1.IsAsync {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
 		return false
 	}
 	return true
 }
-func (this *GrantRoleResponse) Equal(that interface{}) bool {
+func (this *RegisterWalletResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GrantRoleResponse)
+	that1, ok := that.(*RegisterWalletResponse)
 	if !ok {
-		that2, ok := that.(GrantRoleResponse)
+		that2, ok := that.(RegisterWalletResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2607,14 +3409,14 @@ func (this *GrantRoleResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *HasRoleRequest) Equal(that interface{}) bool {
+func (this *NameRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*HasRoleRequest)
+	that1, ok := that.(*NameRequest)
 	if !ok {
-		that2, ok := that.(HasRoleRequest)
+		that2, ok := that.(NameRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2629,22 +3431,43 @@ func (this *HasRoleRequest) Equal(that interface{}) bool {
 	if this.ContractAddress != that1.ContractAddress {
 		return false
 	}
-	if this.Role != that1.Role {
+	if this.Block != that1.Block {
 		return false
 	}
-	if this.Account != that1.Account {
+	return true
+}
+func (this *NameResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NameResponse)
+	if !ok {
+		that2, ok := that.(NameResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
 		return false
 	}
 	return true
 }
-func (this *HasRoleResponse) Equal(that interface{}) bool {
+func (this *SymbolRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*HasRoleResponse)
+	that1, ok := that.(*SymbolRequest)
 	if !ok {
-		that2, ok := that.(HasRoleResponse)
+		that2, ok := that.(SymbolRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2656,19 +3479,22 @@ func (this *HasRoleResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Has != that1.Has {
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Block != that1.Block {
 		return false
 	}
 	return true
 }
-func (this *DeployFCRequest) Equal(that interface{}) bool {
+func (this *SymbolResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeployFCRequest)
+	that1, ok := that.(*SymbolResponse)
 	if !ok {
-		that2, ok := that.(DeployFCRequest)
+		that2, ok := that.(SymbolResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2680,19 +3506,19 @@ func (this *DeployFCRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.PrivateKey != that1.PrivateKey {
+	if this.Symbol != that1.Symbol {
 		return false
 	}
 	return true
 }
-func (this *DeployFCResponse) Equal(that interface{}) bool {
+func (this *TotalSupplyRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeployFCResponse)
+	that1, ok := that.(*TotalSupplyRequest)
 	if !ok {
-		that2, ok := that.(DeployFCResponse)
+		that2, ok := that.(TotalSupplyRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2704,22 +3530,22 @@ func (this *DeployFCResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Hash != that1.Hash {
+	if this.ContractAddress != that1.ContractAddress {
 		return false
 	}
-	if this.ContractAddress != that1.ContractAddress {
+	if this.Block != that1.Block {
 		return false
 	}
 	return true
 }
-func (this *CreateContractsRequest) Equal(that interface{}) bool {
+func (this *TotalSupplyResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CreateContractsRequest)
+	that1, ok := that.(*TotalSupplyResponse)
 	if !ok {
-		that2, ok := that.(CreateContractsRequest)
+		that2, ok := that.(TotalSupplyResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2731,39 +3557,55 @@ func (this *CreateContractsRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.PrivateKey != that1.PrivateKey {
+	if this.Amount != that1.Amount {
 		return false
 	}
-	if this.ContractAddress != that1.ContractAddress {
+	if this.Decimals != that1.Decimals {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.FormattedAmount != that1.FormattedAmount {
 		return false
 	}
-	if this.Symbol != that1.Symbol {
+	return true
+}
+func (this *BalanceOfRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BalanceOfRequest)
+	if !ok {
+		that2, ok := that.(BalanceOfRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.InitialSupply != that1.InitialSupply {
+	if this.ContractAddress != that1.ContractAddress {
 		return false
 	}
-	if len(this.Grantees) != len(that1.Grantees) {
+	if this.Account != that1.Account {
 		return false
 	}
-	for i := range this.Grantees {
-		if this.Grantees[i] != that1.Grantees[i] {
-			return false
-		}
+	if this.Block != that1.Block {
+		return false
 	}
 	return true
 }
-func (this *CreateContractsResponse) Equal(that interface{}) bool {
+func (this *BalanceOfResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CreateContractsResponse)
+	that1, ok := that.(*BalanceOfResponse)
 	if !ok {
-		that2, ok := that.(CreateContractsResponse)
+		that2, ok := that.(BalanceOfResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2775,2590 +3617,6920 @@ func (this *CreateContractsResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Hash != that1.Hash {
+	if this.Amount != that1.Amount {
 		return false
 	}
-	if this.ComplianceAddress != that1.ComplianceAddress {
+	if this.Decimals != that1.Decimals {
 		return false
 	}
-	if this.TokenAddress != that1.TokenAddress {
+	if this.FormattedAmount != that1.FormattedAmount {
 		return false
 	}
 	return true
 }
-func (this *SendETHRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *BatchBalanceOfRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&data.SendETHRequest{")
-	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
-	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
-	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SendETHResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*BatchBalanceOfRequest)
+	if !ok {
+		that2, ok := that.(BatchBalanceOfRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.SendETHResponse{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *BalanceOfETHRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.BalanceOfETHRequest{")
-	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *BalanceOfETHResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.BalanceOfETHResponse{")
-	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeploySTRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Accounts) != len(that1.Accounts) {
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&data.DeploySTRequest{")
-	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
-	s = append(s, "InitialSupply: "+fmt.Sprintf("%#v", this.InitialSupply)+",\n")
-	s = append(s, "ComplianceAddress: "+fmt.Sprintf("%#v", this.ComplianceAddress)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeploySTResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Accounts {
+		if this.Accounts[i] != that1.Accounts[i] {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&data.DeploySTResponse{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *IssueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Block != that1.Block {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&data.IssueRequest{")
-	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
-	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
-	s = append(s, "IsAsync: "+fmt.Sprintf("%#v", this.IsAsync)+",\n")
-	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *IssueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *AccountBalance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.IssueResponse{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RedeemRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*AccountBalance)
+	if !ok {
+		that2, ok := that.(AccountBalance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&data.RedeemRequest{")
-	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
-	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
-	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RedeemResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.RedeemResponse{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TransferRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Account != that1.Account {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&data.TransferRequest{")
-	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
-	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
-	s = append(s, "IsAsync: "+fmt.Sprintf("%#v", this.IsAsync)+",\n")
-	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TransferResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Amount != that1.Amount {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.TransferResponse{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RegisterWalletRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.FormattedAmount != that1.FormattedAmount {
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&data.RegisterWalletRequest{")
-	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
-	s = append(s, "IsAsync: "+fmt.Sprintf("%#v", this.IsAsync)+",\n")
-	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RegisterWalletResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Error != that1.Error {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.RegisterWalletResponse{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *NameRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *BatchBalanceOfResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.NameRequest{")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *NameResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*BatchBalanceOfResponse)
+	if !ok {
+		that2, ok := that.(BatchBalanceOfResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.NameResponse{")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SymbolRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.SymbolRequest{")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SymbolResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Decimals != that1.Decimals {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.SymbolResponse{")
-	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TotalSupplyRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Balances) != len(that1.Balances) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.TotalSupplyRequest{")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TotalSupplyResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Balances {
+		if !this.Balances[i].Equal(that1.Balances[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.TotalSupplyResponse{")
-	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *BalanceOfRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *TokenInfoRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&data.BalanceOfRequest{")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *BalanceOfResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*TokenInfoRequest)
+	if !ok {
+		that2, ok := that.(TokenInfoRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.BalanceOfResponse{")
-	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeployCSRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.DeployCSRequest{")
-	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeployCSResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&data.DeployCSResponse{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GrantRoleRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Block != that1.Block {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&data.GrantRoleRequest{")
-	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
-	s = append(s, "Grantee: "+fmt.Sprintf("%#v", this.Grantee)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *GrantRoleResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *TokenInfoResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.GrantRoleResponse{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HasRoleRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*TokenInfoResponse)
+	if !ok {
+		that2, ok := that.(TokenInfoResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&data.HasRoleRequest{")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
-	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HasRoleResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.HasRoleResponse{")
-	s = append(s, "Has: "+fmt.Sprintf("%#v", this.Has)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeployFCRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Name != that1.Name {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&data.DeployFCRequest{")
-	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeployFCResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Symbol != that1.Symbol {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&data.DeployFCResponse{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CreateContractsRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Decimals != that1.Decimals {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&data.CreateContractsRequest{")
-	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
-	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
-	s = append(s, "InitialSupply: "+fmt.Sprintf("%#v", this.InitialSupply)+",\n")
-	s = append(s, "Grantees: "+fmt.Sprintf("%#v", this.Grantees)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CreateContractsResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.TotalSupply != that1.TotalSupply {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&data.CreateContractsResponse{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "ComplianceAddress: "+fmt.Sprintf("%#v", this.ComplianceAddress)+",\n")
-	s = append(s, "TokenAddress: "+fmt.Sprintf("%#v", this.TokenAddress)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringSecurityToken(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.FormattedTotalSupply != that1.FormattedTotalSupply {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *SendETHRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ComplianceAddress != that1.ComplianceAddress {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SendETHRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SendETHRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
+	if this.ComplianceVersion != that1.ComplianceVersion {
+		return false
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
+	if this.DocumentCount != that1.DocumentCount {
+		return false
 	}
-	if len(m.PrivateKey) > 0 {
-		i -= len(m.PrivateKey)
-		copy(dAtA[i:], m.PrivateKey)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
-		i--
-		dAtA[i] = 0xa
+	if this.Paused != that1.Paused {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SendETHResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TransferPaused != that1.TransferPaused {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SendETHResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SendETHResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	if this.WalletCount != that1.WalletCount {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BalanceOfETHRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.BlockNumber != that1.BlockNumber {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BalanceOfETHRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *BalanceOfETHRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (this *DeployCSRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *BalanceOfETHResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DeployCSRequest)
+	if !ok {
+		that2, ok := that.(DeployCSRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BalanceOfETHResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BalanceOfETHResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DeploySTRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.PrivateKey != that1.PrivateKey {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *DeployCSResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DeploySTRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*DeployCSResponse)
+	if !ok {
+		that2, ok := that.(DeployCSResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	return true
 }
+func (this *GrantRoleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DeploySTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ComplianceAddress) > 0 {
-		i -= len(m.ComplianceAddress)
-		copy(dAtA[i:], m.ComplianceAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ComplianceAddress)))
-		i--
-		dAtA[i] = 0x2a
+	that1, ok := that.(*GrantRoleRequest)
+	if !ok {
+		that2, ok := that.(GrantRoleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.InitialSupply) > 0 {
-		i -= len(m.InitialSupply)
-		copy(dAtA[i:], m.InitialSupply)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.InitialSupply)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x1a
+	if this.PrivateKey != that1.PrivateKey {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	if len(m.PrivateKey) > 0 {
-		i -= len(m.PrivateKey)
-		copy(dAtA[i:], m.PrivateKey)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
-		i--
-		dAtA[i] = 0xa
+	if this.Role != that1.Role {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DeploySTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Grantee != that1.Grantee {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeploySTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *GrantRoleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DeploySTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*GrantRoleResponse)
+	if !ok {
+		that2, ok := that.(GrantRoleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Hash != that1.Hash {
+		return false
+	}
+	return true
 }
+func (this *HasRoleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *IssueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*HasRoleRequest)
+	if !ok {
+		that2, ok := that.(HasRoleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.Account != that1.Account {
+		return false
+	}
+	if this.Block != that1.Block {
+		return false
+	}
+	return true
 }
+func (this *HasRoleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *IssueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*HasRoleResponse)
+	if !ok {
+		that2, ok := that.(HasRoleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Has != that1.Has {
+		return false
+	}
+	return true
 }
-
-func (m *IssueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasLimit != 0 {
-		i = encodeVarintSecurityToken(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x30
+func (this *ContainsWalletRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.IsAsync {
-		i--
-		if m.IsAsync {
-			dAtA[i] = 1
+
+	that1, ok := that.(*ContainsWalletRequest)
+	if !ok {
+		that2, ok := that.(ContainsWalletRequest)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	if this.Account != that1.Account {
+		return false
 	}
-	if len(m.PrivateKey) > 0 {
-		i -= len(m.PrivateKey)
-		copy(dAtA[i:], m.PrivateKey)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
-		i--
-		dAtA[i] = 0xa
+	if this.Block != that1.Block {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *ContainsWalletResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *IssueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ContainsWalletResponse)
+	if !ok {
+		that2, ok := that.(ContainsWalletResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Registered != that1.Registered {
+		return false
+	}
+	return true
 }
+func (this *DeployFCRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *IssueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *IssueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*DeployFCRequest)
+	if !ok {
+		that2, ok := that.(DeployFCRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RedeemRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.PrivateKey != that1.PrivateKey {
+		return false
+	}
+	return true
 }
+func (this *DeployFCResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RedeemRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*DeployFCResponse)
+	if !ok {
+		that2, ok := that.(DeployFCResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	return true
 }
+func (this *CreateContractsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RedeemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x2a
+	that1, ok := that.(*CreateContractsRequest)
+	if !ok {
+		that2, ok := that.(CreateContractsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x1a
+	if this.PrivateKey != that1.PrivateKey {
+		return false
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	if len(m.PrivateKey) > 0 {
-		i -= len(m.PrivateKey)
-		copy(dAtA[i:], m.PrivateKey)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
-		i--
-		dAtA[i] = 0xa
+	if this.Name != that1.Name {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RedeemResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Symbol != that1.Symbol {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RedeemResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	if this.InitialSupply != that1.InitialSupply {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Grantees) != len(that1.Grantees) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	for i := range this.Grantees {
+		if this.Grantees[i] != that1.Grantees[i] {
+			return false
+		}
+	}
+	return true
 }
-
-func (m *TransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasLimit != 0 {
-		i = encodeVarintSecurityToken(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x30
+func (this *CreateContractsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.IsAsync {
-		i--
-		if m.IsAsync {
-			dAtA[i] = 1
+
+	that1, ok := that.(*CreateContractsResponse)
+	if !ok {
+		that2, ok := that.(CreateContractsResponse)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+	if this.Hash != that1.Hash {
+		return false
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	if this.ComplianceAddress != that1.ComplianceAddress {
+		return false
 	}
-	if len(m.PrivateKey) > 0 {
-		i -= len(m.PrivateKey)
-		copy(dAtA[i:], m.PrivateKey)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
-		i--
-		dAtA[i] = 0xa
+	if this.TokenAddress != that1.TokenAddress {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *TransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ListFactoryDeploymentsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *TransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*ListFactoryDeploymentsRequest)
+	if !ok {
+		that2, ok := that.(ListFactoryDeploymentsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RegisterWalletRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RegisterWalletRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Block != that1.Block {
+		return false
+	}
+	if this.FromBlock != that1.FromBlock {
+		return false
+	}
+	return true
 }
-
-func (m *RegisterWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasLimit != 0 {
-		i = encodeVarintSecurityToken(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x28
+func (this *FactoryDeployment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.IsAsync {
-		i--
-		if m.IsAsync {
-			dAtA[i] = 1
+
+	that1, ok := that.(*FactoryDeployment)
+	if !ok {
+		that2, ok := that.(FactoryDeployment)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	if this.Creator != that1.Creator {
+		return false
 	}
-	if len(m.PrivateKey) > 0 {
-		i -= len(m.PrivateKey)
-		copy(dAtA[i:], m.PrivateKey)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
-		i--
-		dAtA[i] = 0xa
+	if this.ComplianceAddress != that1.ComplianceAddress {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RegisterWalletResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TokenAddress != that1.TokenAddress {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RegisterWalletResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RegisterWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	if this.Hash != that1.Hash {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *NameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.BlockNumber != that1.BlockNumber {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.Timestamp != that1.Timestamp {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *NameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Name != that1.Name {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if this.Symbol != that1.Symbol {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SymbolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TotalSupply != that1.TotalSupply {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SymbolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SymbolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.Paused != that1.Paused {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *SymbolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ListFactoryDeploymentsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *SymbolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ListFactoryDeploymentsResponse)
+	if !ok {
+		that2, ok := that.(ListFactoryDeploymentsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Deployments) != len(that1.Deployments) {
+		return false
+	}
+	for i := range this.Deployments {
+		if !this.Deployments[i].Equal(that1.Deployments[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *OnboardingStep) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SymbolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*OnboardingStep)
+	if !ok {
+		that2, ok := that.(OnboardingStep)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TotalSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TotalSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TotalSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.Name != that1.Name {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TotalSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Status != that1.Status {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TotalSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	if this.Hash != that1.Hash {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BalanceOfRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Error != that1.Error {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BalanceOfRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *OnboardInvestorRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BalanceOfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*OnboardInvestorRequest)
+	if !ok {
+		that2, ok := that.(OnboardInvestorRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BalanceOfResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.PrivateKey != that1.PrivateKey {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BalanceOfResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BalanceOfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DeployCSRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Account != that1.Account {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeployCSRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DeployCSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PrivateKey) > 0 {
-		i -= len(m.PrivateKey)
-		copy(dAtA[i:], m.PrivateKey)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
-		i--
-		dAtA[i] = 0xa
+	if this.Amount != that1.Amount {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DeployCSResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.IssueHash != that1.IssueHash {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeployCSResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
 }
+func (this *OnboardInvestorResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DeployCSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*OnboardInvestorResponse)
+	if !ok {
+		that2, ok := that.(OnboardInvestorResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GrantRoleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ComplianceAddress != that1.ComplianceAddress {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GrantRoleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GrantRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x22
+	if this.IssueHash != that1.IssueHash {
+		return false
 	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x1a
+	if this.Completed != that1.Completed {
+		return false
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.Steps) != len(that1.Steps) {
+		return false
 	}
-	if len(m.PrivateKey) > 0 {
-		i -= len(m.PrivateKey)
-		copy(dAtA[i:], m.PrivateKey)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
-		i--
-		dAtA[i] = 0xa
+	for i := range this.Steps {
+		if !this.Steps[i].Equal(that1.Steps[i]) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GrantRoleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SendETHRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 7)
+	s = append(s, "&data.SendETHRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GrantRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (this *SendETHResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&data.SendETHResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HasRoleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *BalanceOfETHRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&data.BalanceOfETHRequest{")
+	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HasRoleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *BalanceOfETHResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&data.BalanceOfETHResponse{")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "FormattedAmount: "+fmt.Sprintf("%#v", this.FormattedAmount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HasRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x1a
+func (this *DeploySTRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 9)
+	s = append(s, "&data.DeploySTRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "InitialSupply: "+fmt.Sprintf("%#v", this.InitialSupply)+",\n")
+	s = append(s, "ComplianceAddress: "+fmt.Sprintf("%#v", this.ComplianceAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeploySTResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 6)
+	s = append(s, "&data.DeploySTResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IssueRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 10)
+	s = append(s, "&data.IssueRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "IsAsync: "+fmt.Sprintf("%#v", this.IsAsync)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HasRoleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *IssueResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&data.IssueResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HasRoleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *RedeemRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&data.RedeemRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HasRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Has {
-		i--
-		if m.Has {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (this *RedeemResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&data.RedeemResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeployFCRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TransferRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 10)
+	s = append(s, "&data.TransferRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "IsAsync: "+fmt.Sprintf("%#v", this.IsAsync)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeployFCRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *TransferResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&data.TransferResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeployFCRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PrivateKey) > 0 {
-		i -= len(m.PrivateKey)
-		copy(dAtA[i:], m.PrivateKey)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
-		i--
-		dAtA[i] = 0xa
+func (this *RegisterWalletRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 9)
+	s = append(s, "&data.RegisterWalletRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	s = append(s, "IsAsync: "+fmt.Sprintf("%#v", this.IsAsync)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeployFCResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RegisterWalletResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&data.RegisterWalletResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeployFCResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *NameRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&data.NameRequest{")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeployFCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+func (this *NameResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 5)
+	s = append(s, "&data.NameResponse{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SymbolRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&data.SymbolRequest{")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CreateContractsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SymbolResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&data.SymbolResponse{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CreateContractsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *TotalSupplyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&data.TotalSupplyRequest{")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CreateContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Grantees) > 0 {
-		for iNdEx := len(m.Grantees) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Grantees[iNdEx])
-			copy(dAtA[i:], m.Grantees[iNdEx])
-			i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Grantees[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
+func (this *TotalSupplyResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.InitialSupply) > 0 {
-		i -= len(m.InitialSupply)
-		copy(dAtA[i:], m.InitialSupply)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.InitialSupply)))
-		i--
-		dAtA[i] = 0x2a
+	s := make([]string, 0, 7)
+	s = append(s, "&data.TotalSupplyResponse{")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "Decimals: "+fmt.Sprintf("%#v", this.Decimals)+",\n")
+	s = append(s, "FormattedAmount: "+fmt.Sprintf("%#v", this.FormattedAmount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BalanceOfRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x22
+	s := make([]string, 0, 7)
+	s = append(s, "&data.BalanceOfRequest{")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BalanceOfResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 7)
+	s = append(s, "&data.BalanceOfResponse{")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "Decimals: "+fmt.Sprintf("%#v", this.Decimals)+",\n")
+	s = append(s, "FormattedAmount: "+fmt.Sprintf("%#v", this.FormattedAmount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BatchBalanceOfRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 7)
+	s = append(s, "&data.BatchBalanceOfRequest{")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Accounts: "+fmt.Sprintf("%#v", this.Accounts)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountBalance) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.PrivateKey) > 0 {
-		i -= len(m.PrivateKey)
-		copy(dAtA[i:], m.PrivateKey)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 8)
+	s = append(s, "&data.AccountBalance{")
+	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "FormattedAmount: "+fmt.Sprintf("%#v", this.FormattedAmount)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BatchBalanceOfResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&data.BatchBalanceOfResponse{")
+	s = append(s, "Decimals: "+fmt.Sprintf("%#v", this.Decimals)+",\n")
+	if this.Balances != nil {
+		s = append(s, "Balances: "+fmt.Sprintf("%#v", this.Balances)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CreateContractsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TokenInfoRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&data.TokenInfoRequest{")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CreateContractsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *TokenInfoResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&data.TokenInfoResponse{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Decimals: "+fmt.Sprintf("%#v", this.Decimals)+",\n")
+	s = append(s, "TotalSupply: "+fmt.Sprintf("%#v", this.TotalSupply)+",\n")
+	s = append(s, "FormattedTotalSupply: "+fmt.Sprintf("%#v", this.FormattedTotalSupply)+",\n")
+	s = append(s, "ComplianceAddress: "+fmt.Sprintf("%#v", this.ComplianceAddress)+",\n")
+	s = append(s, "ComplianceVersion: "+fmt.Sprintf("%#v", this.ComplianceVersion)+",\n")
+	s = append(s, "DocumentCount: "+fmt.Sprintf("%#v", this.DocumentCount)+",\n")
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "TransferPaused: "+fmt.Sprintf("%#v", this.TransferPaused)+",\n")
+	s = append(s, "WalletCount: "+fmt.Sprintf("%#v", this.WalletCount)+",\n")
+	s = append(s, "BlockNumber: "+fmt.Sprintf("%#v", this.BlockNumber)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CreateContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenAddress) > 0 {
-		i -= len(m.TokenAddress)
-		copy(dAtA[i:], m.TokenAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.TokenAddress)))
-		i--
-		dAtA[i] = 0x1a
+func (this *DeployCSRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.ComplianceAddress) > 0 {
-		i -= len(m.ComplianceAddress)
-		copy(dAtA[i:], m.ComplianceAddress)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ComplianceAddress)))
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 5)
+	s = append(s, "&data.DeployCSRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeployCSResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 6)
+	s = append(s, "&data.DeployCSResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GrantRoleRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 8)
+	s = append(s, "&data.GrantRoleRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "Grantee: "+fmt.Sprintf("%#v", this.Grantee)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func encodeVarintSecurityToken(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSecurityToken(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (this *GrantRoleResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	s := make([]string, 0, 5)
+	s = append(s, "&data.GrantRoleResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *SendETHRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *HasRoleRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.PrivateKey)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&data.HasRoleRequest{")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HasRoleResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&data.HasRoleResponse{")
+	s = append(s, "Has: "+fmt.Sprintf("%#v", this.Has)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ContainsWalletRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&data.ContainsWalletRequest{")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ContainsWalletResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&data.ContainsWalletResponse{")
+	s = append(s, "Registered: "+fmt.Sprintf("%#v", this.Registered)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *SendETHResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *DeployFCRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&data.DeployFCRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeployFCResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&data.DeployFCResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *BalanceOfETHRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *CreateContractsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s := make([]string, 0, 10)
+	s = append(s, "&data.CreateContractsRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "InitialSupply: "+fmt.Sprintf("%#v", this.InitialSupply)+",\n")
+	s = append(s, "Grantees: "+fmt.Sprintf("%#v", this.Grantees)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateContractsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 7)
+	s = append(s, "&data.CreateContractsResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "ComplianceAddress: "+fmt.Sprintf("%#v", this.ComplianceAddress)+",\n")
+	s = append(s, "TokenAddress: "+fmt.Sprintf("%#v", this.TokenAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *BalanceOfETHResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ListFactoryDeploymentsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&data.ListFactoryDeploymentsRequest{")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "FromBlock: "+fmt.Sprintf("%#v", this.FromBlock)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FactoryDeployment) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 14)
+	s = append(s, "&data.FactoryDeployment{")
+	s = append(s, "Creator: "+fmt.Sprintf("%#v", this.Creator)+",\n")
+	s = append(s, "ComplianceAddress: "+fmt.Sprintf("%#v", this.ComplianceAddress)+",\n")
+	s = append(s, "TokenAddress: "+fmt.Sprintf("%#v", this.TokenAddress)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "BlockNumber: "+fmt.Sprintf("%#v", this.BlockNumber)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "TotalSupply: "+fmt.Sprintf("%#v", this.TotalSupply)+",\n")
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeploySTRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ListFactoryDeploymentsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.PrivateKey)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&data.ListFactoryDeploymentsResponse{")
+	if this.Deployments != nil {
+		s = append(s, "Deployments: "+fmt.Sprintf("%#v", this.Deployments)+",\n")
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OnboardingStep) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&data.OnboardingStep{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OnboardInvestorRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.InitialSupply)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s := make([]string, 0, 10)
+	s = append(s, "&data.OnboardInvestorRequest{")
+	s = append(s, "PrivateKey: "+fmt.Sprintf("%#v", this.PrivateKey)+",\n")
+	s = append(s, "ContractAddress: "+fmt.Sprintf("%#v", this.ContractAddress)+",\n")
+	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "IssueHash: "+fmt.Sprintf("%#v", this.IssueHash)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OnboardInvestorResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.ComplianceAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&data.OnboardInvestorResponse{")
+	s = append(s, "ComplianceAddress: "+fmt.Sprintf("%#v", this.ComplianceAddress)+",\n")
+	s = append(s, "IssueHash: "+fmt.Sprintf("%#v", this.IssueHash)+",\n")
+	s = append(s, "Completed: "+fmt.Sprintf("%#v", this.Completed)+",\n")
+	if this.Steps != nil {
+		s = append(s, "Steps: "+fmt.Sprintf("%#v", this.Steps)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeploySTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+func valueToGoStringSecurityToken(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *SendETHRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IssueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SendETHRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendETHRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PrivateKey)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.IsAsync {
-		n += 2
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GasLimit != 0 {
-		n += 1 + sovSecurityToken(uint64(m.GasLimit))
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IssueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SendETHResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SendETHResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendETHResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RedeemRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BalanceOfETHRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceOfETHRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceOfETHRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PrivateKey)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RedeemResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+func (m *BalanceOfETHResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BalanceOfETHResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceOfETHResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PrivateKey)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	if m.IsAsync {
-		n += 2
+	if len(m.FormattedAmount) > 0 {
+		i -= len(m.FormattedAmount)
+		copy(dAtA[i:], m.FormattedAmount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.FormattedAmount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GasLimit != 0 {
-		n += 1 + sovSecurityToken(uint64(m.GasLimit))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+func (m *DeploySTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RegisterWalletRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeploySTRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeploySTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PrivateKey)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.ComplianceAddress) > 0 {
+		i -= len(m.ComplianceAddress)
+		copy(dAtA[i:], m.ComplianceAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ComplianceAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.InitialSupply) > 0 {
+		i -= len(m.InitialSupply)
+		copy(dAtA[i:], m.InitialSupply)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.InitialSupply)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.IsAsync {
-		n += 2
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GasLimit != 0 {
-		n += 1 + sovSecurityToken(uint64(m.GasLimit))
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterWalletResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+func (m *DeploySTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NameRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	return n
+func (m *DeploySTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NameResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeploySTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SymbolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+func (m *IssueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SymbolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	return n
+func (m *IssueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IssueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if m.GasLimit != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *TotalSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.IsAsync {
+		i--
+		if m.IsAsync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *BalanceOfRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BalanceOfResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+func (m *IssueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeployCSRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IssueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PrivateKey)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeployCSResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RedeemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RedeemRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedeemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	return n
-}
-
-func (m *GrantRoleRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = len(m.PrivateKey)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GrantRoleResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+func (m *RedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HasRoleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	return n
+func (m *RedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HasRoleResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Has {
-		n += 2
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeployFCRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PrivateKey)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+func (m *TransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeployFCResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	return n
+func (m *TransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateContractsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PrivateKey)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if m.GasLimit != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if m.IsAsync {
+		i--
+		if m.IsAsync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.InitialSupply)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Grantees) > 0 {
-		for _, s := range m.Grantees {
-			l = len(s)
-			n += 1 + l + sovSecurityToken(uint64(l))
-		}
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.ComplianceAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
-	}
-	l = len(m.TokenAddress)
-	if l > 0 {
-		n += 1 + l + sovSecurityToken(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovSecurityToken(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *RegisterWalletRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozSecurityToken(x uint64) (n int) {
-	return sovSecurityToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RegisterWalletRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *SendETHRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RegisterWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
 	}
-	s := strings.Join([]string{`&SendETHRequest{`,
-		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
-		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
-		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SendETHResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.IsAsync {
+		i--
+		if m.IsAsync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	s := strings.Join([]string{`&SendETHResponse{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BalanceOfETHRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&BalanceOfETHRequest{`,
-		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BalanceOfETHResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&BalanceOfETHResponse{`,
-		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DeploySTRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&DeploySTRequest{`,
-		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
-		`InitialSupply:` + fmt.Sprintf("%v", this.InitialSupply) + `,`,
-		`ComplianceAddress:` + fmt.Sprintf("%v", this.ComplianceAddress) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DeploySTResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RegisterWalletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DeploySTResponse{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *IssueRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&IssueRequest{`,
-		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
-		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
-		`IsAsync:` + fmt.Sprintf("%v", this.IsAsync) + `,`,
-		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *RegisterWalletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *IssueResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RegisterWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&IssueResponse{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *RedeemRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&RedeemRequest{`,
-		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
-		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *RedeemResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RedeemResponse{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *NameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *TransferRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&TransferRequest{`,
-		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
-		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
-		`IsAsync:` + fmt.Sprintf("%v", this.IsAsync) + `,`,
-		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TransferResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&TransferResponse{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *RegisterWalletRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&RegisterWalletRequest{`,
-		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
-		`IsAsync:` + fmt.Sprintf("%v", this.IsAsync) + `,`,
-		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *RegisterWalletResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RegisterWalletResponse{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *NameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *NameRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&NameRequest{`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *NameResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SymbolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&NameResponse{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *SymbolRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&SymbolRequest{`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *SymbolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *SymbolResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SymbolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&SymbolResponse{`,
-		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TotalSupplyRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&TotalSupplyRequest{`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *TotalSupplyResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SymbolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&TotalSupplyResponse{`,
-		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *BalanceOfRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&BalanceOfRequest{`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *SymbolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *BalanceOfResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SymbolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&BalanceOfResponse{`,
-		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DeployCSRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TotalSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DeployCSRequest{`,
-		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DeployCSResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DeployCSResponse{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *TotalSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *GrantRoleRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TotalSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&GrantRoleRequest{`,
-		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
-		`Grantee:` + fmt.Sprintf("%v", this.Grantee) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GrantRoleResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&GrantRoleResponse{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *HasRoleRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TotalSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&HasRoleRequest{`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
-		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *HasRoleResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&HasRoleResponse{`,
-		`Has:` + fmt.Sprintf("%v", this.Has) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *TotalSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DeployFCRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FormattedAmount) > 0 {
+		i -= len(m.FormattedAmount)
+		copy(dAtA[i:], m.FormattedAmount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.FormattedAmount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&DeployFCRequest{`,
-		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DeployFCResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Decimals != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceOfRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceOfRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceOfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceOfResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceOfResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceOfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FormattedAmount) > 0 {
+		i -= len(m.FormattedAmount)
+		copy(dAtA[i:], m.FormattedAmount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.FormattedAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchBalanceOfRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchBalanceOfRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchBalanceOfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FormattedAmount) > 0 {
+		i -= len(m.FormattedAmount)
+		copy(dAtA[i:], m.FormattedAmount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.FormattedAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchBalanceOfResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchBalanceOfResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchBalanceOfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSecurityToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.WalletCount != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.WalletCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TransferPaused {
+		i--
+		if m.TransferPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DocumentCount != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.DocumentCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ComplianceVersion != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.ComplianceVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ComplianceAddress) > 0 {
+		i -= len(m.ComplianceAddress)
+		copy(dAtA[i:], m.ComplianceAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ComplianceAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FormattedTotalSupply) > 0 {
+		i -= len(m.FormattedTotalSupply)
+		copy(dAtA[i:], m.FormattedTotalSupply)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.FormattedTotalSupply)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TotalSupply) > 0 {
+		i -= len(m.TotalSupply)
+		copy(dAtA[i:], m.TotalSupply)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.TotalSupply)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeployCSRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeployCSRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeployCSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeployCSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeployCSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeployCSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HasRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HasRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HasRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HasRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HasRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HasRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Has {
+		i--
+		if m.Has {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContainsWalletRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainsWalletRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainsWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContainsWalletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainsWalletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainsWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Registered {
+		i--
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeployFCRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeployFCRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeployFCRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeployFCResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeployFCResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeployFCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantees) > 0 {
+		for iNdEx := len(m.Grantees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Grantees[iNdEx])
+			copy(dAtA[i:], m.Grantees[iNdEx])
+			i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Grantees[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.InitialSupply) > 0 {
+		i -= len(m.InitialSupply)
+		copy(dAtA[i:], m.InitialSupply)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.InitialSupply)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.TokenAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ComplianceAddress) > 0 {
+		i -= len(m.ComplianceAddress)
+		copy(dAtA[i:], m.ComplianceAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ComplianceAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFactoryDeploymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFactoryDeploymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFactoryDeploymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromBlock != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FactoryDeployment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDeployment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDeployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TotalSupply) > 0 {
+		i -= len(m.TotalSupply)
+		copy(dAtA[i:], m.TotalSupply)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.TotalSupply)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.TokenAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ComplianceAddress) > 0 {
+		i -= len(m.ComplianceAddress)
+		copy(dAtA[i:], m.ComplianceAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ComplianceAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFactoryDeploymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFactoryDeploymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFactoryDeploymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deployments) > 0 {
+		for iNdEx := len(m.Deployments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deployments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSecurityToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OnboardingStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnboardingStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnboardingStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OnboardInvestorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnboardInvestorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnboardInvestorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintSecurityToken(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.IssueHash) > 0 {
+		i -= len(m.IssueHash)
+		copy(dAtA[i:], m.IssueHash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.IssueHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.PrivateKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OnboardInvestorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnboardInvestorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnboardInvestorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSecurityToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.IssueHash) > 0 {
+		i -= len(m.IssueHash)
+		copy(dAtA[i:], m.IssueHash)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.IssueHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ComplianceAddress) > 0 {
+		i -= len(m.ComplianceAddress)
+		copy(dAtA[i:], m.ComplianceAddress)
+		i = encodeVarintSecurityToken(dAtA, i, uint64(len(m.ComplianceAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSecurityToken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSecurityToken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SendETHRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *SendETHResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceOfETHRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceOfETHResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.FormattedAmount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *DeploySTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.InitialSupply)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ComplianceAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *DeploySTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *IssueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.IsAsync {
+		n += 2
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSecurityToken(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *IssueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *RedeemRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *RedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.IsAsync {
+		n += 2
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSecurityToken(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *TransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterWalletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.IsAsync {
+		n += 2
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSecurityToken(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *RegisterWalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *NameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *NameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *SymbolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *SymbolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *TotalSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *TotalSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovSecurityToken(uint64(m.Decimals))
+	}
+	l = len(m.FormattedAmount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceOfRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceOfResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovSecurityToken(uint64(m.Decimals))
+	}
+	l = len(m.FormattedAmount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchBalanceOfRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovSecurityToken(uint64(l))
+		}
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.FormattedAmount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchBalanceOfResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		n += 1 + sovSecurityToken(uint64(m.Decimals))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovSecurityToken(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovSecurityToken(uint64(m.Decimals))
+	}
+	l = len(m.TotalSupply)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.FormattedTotalSupply)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ComplianceAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.ComplianceVersion != 0 {
+		n += 1 + sovSecurityToken(uint64(m.ComplianceVersion))
+	}
+	if m.DocumentCount != 0 {
+		n += 1 + sovSecurityToken(uint64(m.DocumentCount))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.TransferPaused {
+		n += 2
+	}
+	if m.WalletCount != 0 {
+		n += 1 + sovSecurityToken(uint64(m.WalletCount))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovSecurityToken(uint64(m.BlockNumber))
+	}
+	return n
+}
+
+func (m *DeployCSRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *DeployCSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *HasRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *HasRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Has {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContainsWalletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *ContainsWalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Registered {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeployFCRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *DeployFCResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.InitialSupply)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if len(m.Grantees) > 0 {
+		for _, s := range m.Grantees {
+			l = len(s)
+			n += 1 + l + sovSecurityToken(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ComplianceAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *ListFactoryDeploymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.FromBlock != 0 {
+		n += 1 + sovSecurityToken(uint64(m.FromBlock))
+	}
+	return n
+}
+
+func (m *FactoryDeployment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ComplianceAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovSecurityToken(uint64(m.BlockNumber))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSecurityToken(uint64(m.Timestamp))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.TotalSupply)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListFactoryDeploymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deployments) > 0 {
+		for _, e := range m.Deployments {
+			l = e.Size()
+			n += 1 + l + sovSecurityToken(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OnboardingStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSecurityToken(uint64(m.Status))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	return n
+}
+
+func (m *OnboardInvestorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.IssueHash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSecurityToken(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *OnboardInvestorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComplianceAddress)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	l = len(m.IssueHash)
+	if l > 0 {
+		n += 1 + l + sovSecurityToken(uint64(l))
+	}
+	if m.Completed {
+		n += 2
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovSecurityToken(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSecurityToken(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSecurityToken(x uint64) (n int) {
+	return sovSecurityToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SendETHRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SendETHRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SendETHResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SendETHResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BalanceOfETHRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BalanceOfETHRequest{`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BalanceOfETHResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BalanceOfETHResponse{`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`FormattedAmount:` + fmt.Sprintf("%v", this.FormattedAmount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeploySTRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeploySTRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`InitialSupply:` + fmt.Sprintf("%v", this.InitialSupply) + `,`,
+		`ComplianceAddress:` + fmt.Sprintf("%v", this.ComplianceAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeploySTResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeploySTResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IssueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IssueRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`IsAsync:` + fmt.Sprintf("%v", this.IsAsync) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IssueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IssueResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RedeemRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RedeemRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RedeemResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RedeemResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransferRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransferRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`IsAsync:` + fmt.Sprintf("%v", this.IsAsync) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransferResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransferResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegisterWalletRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegisterWalletRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`IsAsync:` + fmt.Sprintf("%v", this.IsAsync) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegisterWalletResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegisterWalletResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NameRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NameRequest{`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NameResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NameResponse{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SymbolRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SymbolRequest{`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SymbolResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SymbolResponse{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TotalSupplyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TotalSupplyRequest{`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TotalSupplyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TotalSupplyResponse{`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`Decimals:` + fmt.Sprintf("%v", this.Decimals) + `,`,
+		`FormattedAmount:` + fmt.Sprintf("%v", this.FormattedAmount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BalanceOfRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BalanceOfRequest{`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BalanceOfResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BalanceOfResponse{`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`Decimals:` + fmt.Sprintf("%v", this.Decimals) + `,`,
+		`FormattedAmount:` + fmt.Sprintf("%v", this.FormattedAmount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchBalanceOfRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BatchBalanceOfRequest{`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Accounts:` + fmt.Sprintf("%v", this.Accounts) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountBalance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountBalance{`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`FormattedAmount:` + fmt.Sprintf("%v", this.FormattedAmount) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchBalanceOfResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBalances := "[]*AccountBalance{"
+	for _, f := range this.Balances {
+		repeatedStringForBalances += strings.Replace(f.String(), "AccountBalance", "AccountBalance", 1) + ","
+	}
+	repeatedStringForBalances += "}"
+	s := strings.Join([]string{`&BatchBalanceOfResponse{`,
+		`Decimals:` + fmt.Sprintf("%v", this.Decimals) + `,`,
+		`Balances:` + repeatedStringForBalances + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TokenInfoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TokenInfoRequest{`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TokenInfoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TokenInfoResponse{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Decimals:` + fmt.Sprintf("%v", this.Decimals) + `,`,
+		`TotalSupply:` + fmt.Sprintf("%v", this.TotalSupply) + `,`,
+		`FormattedTotalSupply:` + fmt.Sprintf("%v", this.FormattedTotalSupply) + `,`,
+		`ComplianceAddress:` + fmt.Sprintf("%v", this.ComplianceAddress) + `,`,
+		`ComplianceVersion:` + fmt.Sprintf("%v", this.ComplianceVersion) + `,`,
+		`DocumentCount:` + fmt.Sprintf("%v", this.DocumentCount) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`TransferPaused:` + fmt.Sprintf("%v", this.TransferPaused) + `,`,
+		`WalletCount:` + fmt.Sprintf("%v", this.WalletCount) + `,`,
+		`BlockNumber:` + fmt.Sprintf("%v", this.BlockNumber) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeployCSRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeployCSRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeployCSResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeployCSResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GrantRoleRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GrantRoleRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Grantee:` + fmt.Sprintf("%v", this.Grantee) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GrantRoleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GrantRoleResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HasRoleRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HasRoleRequest{`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HasRoleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HasRoleResponse{`,
+		`Has:` + fmt.Sprintf("%v", this.Has) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainsWalletRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainsWalletRequest{`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainsWalletResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainsWalletResponse{`,
+		`Registered:` + fmt.Sprintf("%v", this.Registered) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeployFCRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeployFCRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeployFCResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeployFCResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateContractsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateContractsRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`InitialSupply:` + fmt.Sprintf("%v", this.InitialSupply) + `,`,
+		`Grantees:` + fmt.Sprintf("%v", this.Grantees) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateContractsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateContractsResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`ComplianceAddress:` + fmt.Sprintf("%v", this.ComplianceAddress) + `,`,
+		`TokenAddress:` + fmt.Sprintf("%v", this.TokenAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListFactoryDeploymentsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListFactoryDeploymentsRequest{`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`FromBlock:` + fmt.Sprintf("%v", this.FromBlock) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FactoryDeployment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FactoryDeployment{`,
+		`Creator:` + fmt.Sprintf("%v", this.Creator) + `,`,
+		`ComplianceAddress:` + fmt.Sprintf("%v", this.ComplianceAddress) + `,`,
+		`TokenAddress:` + fmt.Sprintf("%v", this.TokenAddress) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`BlockNumber:` + fmt.Sprintf("%v", this.BlockNumber) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`TotalSupply:` + fmt.Sprintf("%v", this.TotalSupply) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListFactoryDeploymentsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeployments := "[]*FactoryDeployment{"
+	for _, f := range this.Deployments {
+		repeatedStringForDeployments += strings.Replace(f.String(), "FactoryDeployment", "FactoryDeployment", 1) + ","
+	}
+	repeatedStringForDeployments += "}"
+	s := strings.Join([]string{`&ListFactoryDeploymentsResponse{`,
+		`Deployments:` + repeatedStringForDeployments + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OnboardingStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OnboardingStep{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OnboardInvestorRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OnboardInvestorRequest{`,
+		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
+		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`IssueHash:` + fmt.Sprintf("%v", this.IssueHash) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OnboardInvestorResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]*OnboardingStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(f.String(), "OnboardingStep", "OnboardingStep", 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	s := strings.Join([]string{`&OnboardInvestorResponse{`,
+		`ComplianceAddress:` + fmt.Sprintf("%v", this.ComplianceAddress) + `,`,
+		`IssueHash:` + fmt.Sprintf("%v", this.IssueHash) + `,`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSecurityToken(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SendETHRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendETHRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendETHRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendETHResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendETHResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendETHResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceOfETHRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceOfETHRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceOfETHRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceOfETHResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceOfETHResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceOfETHResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FormattedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FormattedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeploySTRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploySTRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploySTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeploySTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploySTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploySTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAsync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAsync = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedeemRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedeemRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedeemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedeemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedeemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAsync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAsync = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterWalletRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterWalletRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAsync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAsync = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&DeployFCResponse{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (this *CreateContractsRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *RegisterWalletResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterWalletResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&CreateContractsRequest{`,
-		`PrivateKey:` + fmt.Sprintf("%v", this.PrivateKey) + `,`,
-		`ContractAddress:` + fmt.Sprintf("%v", this.ContractAddress) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
-		`InitialSupply:` + fmt.Sprintf("%v", this.InitialSupply) + `,`,
-		`Grantees:` + fmt.Sprintf("%v", this.Grantees) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *CreateContractsResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *NameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&CreateContractsResponse{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`ComplianceAddress:` + fmt.Sprintf("%v", this.ComplianceAddress) + `,`,
-		`TokenAddress:` + fmt.Sprintf("%v", this.TokenAddress) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringSecurityToken(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *SendETHRequest) Unmarshal(dAtA []byte) error {
+func (m *NameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5381,15 +10553,15 @@ func (m *SendETHRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendETHRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendETHRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5417,11 +10589,61 @@ func (m *SendETHRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5449,11 +10671,11 @@ func (m *SendETHRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5481,7 +10703,7 @@ func (m *SendETHRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Block = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5504,7 +10726,7 @@ func (m *SendETHRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendETHResponse) Unmarshal(dAtA []byte) error {
+func (m *SymbolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5527,15 +10749,15 @@ func (m *SendETHResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendETHResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SymbolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendETHResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SymbolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5563,7 +10785,7 @@ func (m *SendETHResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5586,7 +10808,7 @@ func (m *SendETHResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BalanceOfETHRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5609,15 +10831,47 @@ func (m *BalanceOfETHRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BalanceOfETHRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BalanceOfETHRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5645,7 +10899,7 @@ func (m *BalanceOfETHRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Block = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5668,7 +10922,7 @@ func (m *BalanceOfETHRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BalanceOfETHResponse) Unmarshal(dAtA []byte) error {
+func (m *TotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5691,10 +10945,10 @@ func (m *BalanceOfETHResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BalanceOfETHResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BalanceOfETHResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5729,6 +10983,57 @@ func (m *BalanceOfETHResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FormattedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FormattedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSecurityToken(dAtA[iNdEx:])
@@ -5750,7 +11055,7 @@ func (m *BalanceOfETHResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeploySTRequest) Unmarshal(dAtA []byte) error {
+func (m *BalanceOfRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5773,15 +11078,15 @@ func (m *DeploySTRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeploySTRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BalanceOfRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeploySTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BalanceOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5809,11 +11114,11 @@ func (m *DeploySTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5841,11 +11146,11 @@ func (m *DeploySTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5873,11 +11178,61 @@ func (m *DeploySTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Block = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceOfResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceOfResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5905,11 +11260,30 @@ func (m *DeploySTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialSupply = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FormattedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5937,7 +11311,7 @@ func (m *DeploySTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComplianceAddress = string(dAtA[iNdEx:postIndex])
+			m.FormattedAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5960,7 +11334,7 @@ func (m *DeploySTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeploySTResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchBalanceOfRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5983,15 +11357,15 @@ func (m *DeploySTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeploySTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchBalanceOfRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeploySTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchBalanceOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6019,11 +11393,11 @@ func (m *DeploySTResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6051,7 +11425,39 @@ func (m *DeploySTResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6074,7 +11480,7 @@ func (m *DeploySTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IssueRequest) Unmarshal(dAtA []byte) error {
+func (m *AccountBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6097,15 +11503,15 @@ func (m *IssueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IssueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IssueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6133,11 +11539,11 @@ func (m *IssueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6165,11 +11571,11 @@ func (m *IssueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FormattedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6197,11 +11603,11 @@ func (m *IssueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.FormattedAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6229,13 +11635,63 @@ func (m *IssueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchBalanceOfResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchBalanceOfResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchBalanceOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAsync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			var v int
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSecurityToken
@@ -6245,17 +11701,16 @@ func (m *IssueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Decimals |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsAsync = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
-			m.GasLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSecurityToken
@@ -6265,11 +11720,26 @@ func (m *IssueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, &AccountBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSecurityToken(dAtA[iNdEx:])
@@ -6291,7 +11761,7 @@ func (m *IssueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IssueResponse) Unmarshal(dAtA []byte) error {
+func (m *TokenInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6314,15 +11784,15 @@ func (m *IssueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IssueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IssueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6350,7 +11820,39 @@ func (m *IssueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6373,7 +11875,7 @@ func (m *IssueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedeemRequest) Unmarshal(dAtA []byte) error {
+func (m *TokenInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6396,15 +11898,15 @@ func (m *RedeemRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedeemRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedeemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6432,11 +11934,11 @@ func (m *RedeemRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6464,11 +11966,30 @@ func (m *RedeemRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6496,11 +12017,11 @@ func (m *RedeemRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.TotalSupply = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FormattedTotalSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6528,11 +12049,209 @@ func (m *RedeemRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.FormattedTotalSupply = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceVersion", wireType)
+			}
+			m.ComplianceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComplianceVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentCount", wireType)
+			}
+			m.DocumentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TransferPaused = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletCount", wireType)
+			}
+			m.WalletCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeployCSRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeployCSRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeployCSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6560,7 +12279,7 @@ func (m *RedeemRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.PrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6583,7 +12302,7 @@ func (m *RedeemRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedeemResponse) Unmarshal(dAtA []byte) error {
+func (m *DeployCSResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6606,10 +12325,10 @@ func (m *RedeemResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedeemResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeployCSResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeployCSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6644,6 +12363,38 @@ func (m *RedeemResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSecurityToken(dAtA[iNdEx:])
@@ -6665,7 +12416,7 @@ func (m *RedeemResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferRequest) Unmarshal(dAtA []byte) error {
+func (m *GrantRoleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6688,10 +12439,10 @@ func (m *TransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrantRoleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrantRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6760,7 +12511,7 @@ func (m *TransferRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6788,11 +12539,11 @@ func (m *TransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6820,47 +12571,8 @@ func (m *TransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAsync", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSecurityToken
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsAsync = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
-			}
-			m.GasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSecurityToken
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSecurityToken(dAtA[iNdEx:])
@@ -6882,7 +12594,7 @@ func (m *TransferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferResponse) Unmarshal(dAtA []byte) error {
+func (m *GrantRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6905,10 +12617,10 @@ func (m *TransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrantRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrantRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6964,7 +12676,7 @@ func (m *TransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterWalletRequest) Unmarshal(dAtA []byte) error {
+func (m *HasRoleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6987,15 +12699,15 @@ func (m *RegisterWalletRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterWalletRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HasRoleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HasRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7023,11 +12735,11 @@ func (m *RegisterWalletRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7055,7 +12767,7 @@ func (m *RegisterWalletRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -7090,10 +12802,10 @@ func (m *RegisterWalletRequest) Unmarshal(dAtA []byte) error {
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAsync", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSecurityToken
@@ -7103,17 +12815,79 @@ func (m *RegisterWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsAsync = bool(v != 0)
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecurityToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HasRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecurityToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HasRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HasRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Has", wireType)
 			}
-			m.GasLimit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSecurityToken
@@ -7123,11 +12897,12 @@ func (m *RegisterWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Has = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSecurityToken(dAtA[iNdEx:])
@@ -7149,7 +12924,7 @@ func (m *RegisterWalletRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterWalletResponse) Unmarshal(dAtA []byte) error {
+func (m *ContainsWalletRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7172,15 +12947,79 @@ func (m *RegisterWalletResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterWalletResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainsWalletRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainsWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7208,7 +13047,7 @@ func (m *RegisterWalletResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Block = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7231,7 +13070,7 @@ func (m *RegisterWalletResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NameRequest) Unmarshal(dAtA []byte) error {
+func (m *ContainsWalletResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7254,17 +13093,17 @@ func (m *NameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainsWalletResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainsWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSecurityToken
@@ -7274,24 +13113,12 @@ func (m *NameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Registered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSecurityToken(dAtA[iNdEx:])
@@ -7313,7 +13140,7 @@ func (m *NameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NameResponse) Unmarshal(dAtA []byte) error {
+func (m *DeployFCRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7336,15 +13163,15 @@ func (m *NameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeployFCRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeployFCRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7372,7 +13199,7 @@ func (m *NameResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7395,7 +13222,7 @@ func (m *NameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SymbolRequest) Unmarshal(dAtA []byte) error {
+func (m *DeployFCResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7418,13 +13245,45 @@ func (m *SymbolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SymbolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeployFCResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SymbolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeployFCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
@@ -7477,7 +13336,7 @@ func (m *SymbolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SymbolResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateContractsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7500,15 +13359,15 @@ func (m *SymbolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SymbolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateContractsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SymbolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7536,61 +13395,43 @@ func (m *SymbolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.PrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSecurityToken(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSecurityToken
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalSupplyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSecurityToken
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalSupplyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7618,61 +13459,75 @@ func (m *TotalSupplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSecurityToken(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSecurityToken
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalSupplyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSecurityToken
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSupply", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalSupplyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.InitialSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7700,7 +13555,7 @@ func (m *TotalSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Grantees = append(m.Grantees, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7723,7 +13578,7 @@ func (m *TotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BalanceOfRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateContractsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7746,15 +13601,15 @@ func (m *BalanceOfRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BalanceOfRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateContractsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BalanceOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7782,11 +13637,11 @@ func (m *BalanceOfRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7814,61 +13669,11 @@ func (m *BalanceOfRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.ComplianceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSecurityToken(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BalanceOfResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSecurityToken
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BalanceOfResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BalanceOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7896,7 +13701,7 @@ func (m *BalanceOfResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.TokenAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7919,7 +13724,7 @@ func (m *BalanceOfResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeployCSRequest) Unmarshal(dAtA []byte) error {
+func (m *ListFactoryDeploymentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7942,15 +13747,15 @@ func (m *DeployCSRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeployCSRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFactoryDeploymentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeployCSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFactoryDeploymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7978,61 +13783,11 @@ func (m *DeployCSRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSecurityToken(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeployCSResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSecurityToken
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeployCSResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeployCSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8060,13 +13815,13 @@ func (m *DeployCSResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Block = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
 			}
-			var stringLen uint64
+			m.FromBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSecurityToken
@@ -8076,24 +13831,11 @@ func (m *DeployCSResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FromBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSecurityToken(dAtA[iNdEx:])
@@ -8115,7 +13857,7 @@ func (m *DeployCSResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GrantRoleRequest) Unmarshal(dAtA []byte) error {
+func (m *FactoryDeployment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8138,15 +13880,15 @@ func (m *GrantRoleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GrantRoleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FactoryDeployment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GrantRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FactoryDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8174,11 +13916,11 @@ func (m *GrantRoleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8206,11 +13948,11 @@ func (m *GrantRoleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.ComplianceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8238,11 +13980,11 @@ func (m *GrantRoleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.TokenAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8270,61 +14012,49 @@ func (m *GrantRoleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSecurityToken(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GrantRoleResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSecurityToken
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GrantRoleResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GrantRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8352,61 +14082,11 @@ func (m *GrantRoleResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSecurityToken(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HasRoleRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSecurityToken
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HasRoleRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HasRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8434,11 +14114,11 @@ func (m *HasRoleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8466,13 +14146,13 @@ func (m *HasRoleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.TotalSupply = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSecurityToken
@@ -8482,24 +14162,12 @@ func (m *HasRoleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSecurityToken(dAtA[iNdEx:])
@@ -8521,7 +14189,7 @@ func (m *HasRoleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HasRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *ListFactoryDeploymentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8544,17 +14212,17 @@ func (m *HasRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HasRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFactoryDeploymentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HasRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFactoryDeploymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Has", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployments", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSecurityToken
@@ -8564,12 +14232,26 @@ func (m *HasRoleResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Has = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecurityToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deployments = append(m.Deployments, &FactoryDeployment{})
+			if err := m.Deployments[len(m.Deployments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSecurityToken(dAtA[iNdEx:])
@@ -8591,7 +14273,7 @@ func (m *HasRoleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeployFCRequest) Unmarshal(dAtA []byte) error {
+func (m *OnboardingStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8614,15 +14296,15 @@ func (m *DeployFCRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeployFCRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnboardingStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeployFCRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnboardingStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8650,59 +14332,28 @@ func (m *DeployFCRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSecurityToken(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeployFCResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSecurityToken
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StepStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeployFCResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeployFCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
@@ -8734,9 +14385,9 @@ func (m *DeployFCResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8764,7 +14415,7 @@ func (m *DeployFCResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8787,7 +14438,7 @@ func (m *DeployFCResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateContractsRequest) Unmarshal(dAtA []byte) error {
+func (m *OnboardInvestorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8810,10 +14461,10 @@ func (m *CreateContractsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateContractsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnboardInvestorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnboardInvestorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8882,7 +14533,7 @@ func (m *CreateContractsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8910,11 +14561,11 @@ func (m *CreateContractsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8942,11 +14593,11 @@ func (m *CreateContractsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8974,13 +14625,13 @@ func (m *CreateContractsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialSupply = string(dAtA[iNdEx:postIndex])
+			m.IssueHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantees", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			var stringLen uint64
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSecurityToken
@@ -8990,24 +14641,11 @@ func (m *CreateContractsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSecurityToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Grantees = append(m.Grantees, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSecurityToken(dAtA[iNdEx:])
@@ -9029,7 +14667,7 @@ func (m *CreateContractsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *OnboardInvestorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9052,15 +14690,15 @@ func (m *CreateContractsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnboardInvestorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnboardInvestorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9088,11 +14726,11 @@ func (m *CreateContractsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ComplianceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9120,13 +14758,33 @@ func (m *CreateContractsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComplianceAddress = string(dAtA[iNdEx:postIndex])
+			m.IssueHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecurityToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSecurityToken
@@ -9136,23 +14794,25 @@ func (m *CreateContractsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSecurityToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSecurityToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			m.Steps = append(m.Steps, &OnboardingStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,359 @@
+package data
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidAddress = errors.New("invalid ethereum address")
+	ErrZeroAddress    = errors.New("empty ethereum address")
+	ErrChecksum       = errors.New("invalid EIP-55 checksum")
+	ErrDeniedAddress  = errors.New("denied ethereum address")
+
+	// BurnAddresses are commonly used to destroy assets, nothing sent there can be recovered
+	BurnAddresses = []string{
+		"0x000000000000000000000000000000000000dEaD",
+		"0xdEAD000000000000000042069420694206942069",
+	}
+)
+
+// ValidatePolicy tunes what Validate accepts. The zero address is always rejected.
+type ValidatePolicy struct {
+	checksum          bool
+	denied            map[common.Address]string
+	contractRecipient bool
+}
+
+type ValidateOption interface {
+	Apply(*ValidatePolicy)
+}
+
+type ChecksumOpt bool
+
+func (o ChecksumOpt) Apply(p *ValidatePolicy) {
+	p.checksum = bool(o)
+}
+
+// WithChecksum requires addresses in the EIP-55 mixed case form, so that a mistyped address is rejected.
+// Without it, a mixed case address must still have a valid checksum, but lower and upper case ones are accepted.
+func WithChecksum() ChecksumOpt {
+	return ChecksumOpt(true)
+}
+
+type DeniedAddressesOpt struct {
+	addresses []common.Address
+	reason    string
+}
+
+func (o DeniedAddressesOpt) Apply(p *ValidatePolicy) {
+	for _, addr := range o.addresses {
+		p.denied[addr] = o.reason
+	}
+}
+
+// WithDeniedAddresses rejects the addresses in every address field.
+func WithDeniedAddresses(addresses ...string) DeniedAddressesOpt {
+	o := DeniedAddressesOpt{reason: "deny-listed"}
+	for _, addr := range addresses {
+		if !common.IsHexAddress(addr) {
+			panic("invalid denied address: " + addr)
+		}
+		o.addresses = append(o.addresses, common.HexToAddress(addr))
+	}
+	return o
+}
+
+// WithDeniedBurnAddresses rejects the BurnAddresses.
+func WithDeniedBurnAddresses() DeniedAddressesOpt {
+	o := WithDeniedAddresses(BurnAddresses...)
+	o.reason = "burn address"
+	return o
+}
+
+type DeniedContractRecipientOpt bool
+
+func (o DeniedContractRecipientOpt) Apply(p *ValidatePolicy) {
+	p.contractRecipient = bool(o)
+}
+
+// WithDeniedContractRecipient rejects issuing or transferring tokens to the token contract itself, where they would be locked.
+func WithDeniedContractRecipient() DeniedContractRecipientOpt {
+	return DeniedContractRecipientOpt(true)
+}
+
+func newValidatePolicy(opts ...ValidateOption) *ValidatePolicy {
+	p := &ValidatePolicy{denied: make(map[common.Address]string)}
+	for i := range opts {
+		opts[i].Apply(p)
+	}
+	return p
+}
+
+func (p *ValidatePolicy) validateAddress(address string) error {
+	if !common.IsHexAddress(address) {
+		return errors.Wrapf(ErrInvalidAddress, "%q", address)
+	}
+
+	addr := common.HexToAddress(address)
+	if addr == (common.Address{}) {
+		return ErrZeroAddress
+	}
+
+	var (
+		hex     = strings.TrimPrefix(strings.TrimPrefix(address, "0x"), "0X")
+		mixed   = hex != strings.ToLower(hex) && hex != strings.ToUpper(hex)
+		checked = addr.Hex()
+	)
+	switch {
+	case p.checksum && address != checked:
+		return errors.Wrapf(ErrChecksum, "%s, expected %s", address, checked)
+	case mixed && "0x"+hex != checked:
+		return errors.Wrapf(ErrChecksum, "%s, expected %s", address, checked)
+	}
+
+	if reason, ok := p.denied[addr]; ok {
+		return errors.Wrapf(ErrDeniedAddress, "%s is a %s", addr.Hex(), reason)
+	}
+	return nil
+}
+
+// validateRecipient also rejects the contract receiving its own tokens, if the policy says so.
+func (p *ValidatePolicy) validateRecipient(recipient, contract string) error {
+	if err := p.validateAddress(recipient); err != nil {
+		return err
+	}
+	if p.contractRecipient && common.HexToAddress(recipient) == common.HexToAddress(contract) {
+		return errors.Wrapf(ErrDeniedAddress, "%s is the token contract itself", recipient)
+	}
+	return nil
+}
+
+const (
+	CodeInvalid           = "invalid"
+	CodeRequired          = "required"
+	CodeInvalidAddress    = "invalid_address"
+	CodeZeroAddress       = "zero_address"
+	CodeChecksum          = "checksum"
+	CodeDeniedAddress     = "denied_address"
+	CodeInvalidAmount     = "invalid_amount"
+	CodeNegativeAmount    = "negative_amount"
+	CodeTooManyDecimals   = "too_many_decimals"
+	CodeInvalidPrivateKey = "invalid_private_key"
+	CodeInvalidRole       = "invalid_role"
+	CodeInvalidBlock      = "invalid_block"
+	CodeInvalidTxHash     = "invalid_tx_hash"
+)
+
+var (
+	ErrRequired          = errors.New("required")
+	ErrInvalidPrivateKey = errors.New("invalid private key")
+	ErrInvalidRole       = errors.New("invalid role, expected 32 bytes in hex")
+	ErrInvalidTxHash     = errors.New("invalid transaction hash, expected 32 bytes in 0x prefixed hex")
+)
+
+// FieldError is the error of a single field, Field being its JSON name such as "grantees[1]".
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+
+	Err error `json:"-"`
+}
+
+// NewFieldError derives the code from the error.
+func NewFieldError(field string, err error) FieldError {
+	return FieldError{Field: field, Code: errorCode(err), Message: err.Error(), Err: err}
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError collects the errors of every invalid field of a request.
+type ValidationError struct {
+	Fields []FieldError `json:"fields"`
+}
+
+func NewValidationError(fields ...FieldError) *ValidationError {
+	return &ValidationError{Fields: fields}
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return "invalid request: " + strings.Join(msgs, "; ")
+}
+
+// Is tells if any of the fields failed because of target, so that errors.Is(err, ErrChecksum) works.
+func (e *ValidationError) Is(target error) bool {
+	for _, f := range e.Fields {
+		if errors.Is(f.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Field returns the error of the field, if any.
+func (e *ValidationError) Field(field string) (FieldError, bool) {
+	for _, f := range e.Fields {
+		if f.Field == field {
+			return f, true
+		}
+	}
+	return FieldError{}, false
+}
+
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, ErrRequired):
+		return CodeRequired
+	case errors.Is(err, ErrZeroAddress):
+		return CodeZeroAddress
+	case errors.Is(err, ErrChecksum):
+		return CodeChecksum
+	case errors.Is(err, ErrDeniedAddress):
+		return CodeDeniedAddress
+	case errors.Is(err, ErrInvalidAddress):
+		return CodeInvalidAddress
+	case errors.Is(err, ErrNegativeAmount):
+		return CodeNegativeAmount
+	case errors.Is(err, ErrTooManyDecimals):
+		return CodeTooManyDecimals
+	case errors.Is(err, ErrInvalidPrivateKey):
+		return CodeInvalidPrivateKey
+	case errors.Is(err, ErrInvalidRole):
+		return CodeInvalidRole
+	case errors.Is(err, ErrInvalidBlock), errors.Is(err, ErrPendingBlock):
+		return CodeInvalidBlock
+	case errors.Is(err, ErrInvalidTxHash):
+		return CodeInvalidTxHash
+	case errors.Is(err, ErrInvalidAmount), errors.Is(err, ErrUnsupportedAmountType):
+		return CodeInvalidAmount
+	default:
+		return CodeInvalid
+	}
+}
+
+// validator checks the fields of a request one by one, collecting the errors.
+type validator struct {
+	policy *ValidatePolicy
+	fields []FieldError
+}
+
+func newValidator(opts ...ValidateOption) *validator {
+	return &validator{policy: newValidatePolicy(opts...)}
+}
+
+func (v *validator) fail(field string, err error) {
+	v.fields = append(v.fields, NewFieldError(field, err))
+}
+
+func (v *validator) required(field, value string) bool {
+	if value == "" {
+		v.fail(field, ErrRequired)
+		return false
+	}
+	return true
+}
+
+func (v *validator) privateKey(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	// never echo the key in the message
+	if _, err := crypto.HexToECDSA(value); err != nil {
+		v.fail(field, ErrInvalidPrivateKey)
+	}
+}
+
+func (v *validator) address(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if err := v.policy.validateAddress(value); err != nil {
+		v.fail(field, err)
+	}
+}
+
+func (v *validator) recipient(field, value, contract string) {
+	if !v.required(field, value) {
+		return
+	}
+	if err := v.policy.validateRecipient(value, contract); err != nil {
+		v.fail(field, err)
+	}
+}
+
+// amount checks the format only, the decimals of the token being unknown here.
+func (v *validator) amount(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if _, err := ParseAmount(value); err != nil {
+		v.fail(field, err)
+	}
+}
+
+func (v *validator) amountWithDecimals(field, value string, decimals int) {
+	if !v.required(field, value) {
+		return
+	}
+	if _, err := ToWei(value, decimals); err != nil {
+		v.fail(field, err)
+	}
+}
+
+func (v *validator) role(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if b, err := hex.DecodeString(value); err != nil || len(b) != 32 {
+		v.fail(field, errors.Wrapf(ErrInvalidRole, "%q", value))
+	}
+}
+
+// block is optional, the latest block being read by default.
+func (v *validator) block(field, value string) {
+	if _, _, err := ParseBlock(value); err != nil {
+		v.fail(field, err)
+	}
+}
+
+// minedBlock is a block other than the pending one.
+func (v *validator) minedBlock(field, value string) {
+	if value == BlockPending {
+		v.fail(field, ErrPendingBlock)
+		return
+	}
+	v.block(field, value)
+}
+
+// txHash is optional.
+func (v *validator) txHash(field, value string) {
+	if value == "" {
+		return
+	}
+	if b, err := hexutil.Decode(value); err != nil || len(b) != common.HashLength {
+		v.fail(field, errors.Wrapf(ErrInvalidTxHash, "%q", value))
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return NewValidationError(v.fields...)
+}
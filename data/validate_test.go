@@ -0,0 +1,122 @@
+package data
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	TestChecksummed = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	TestContract    = "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"
+	TestPrivateKey  = "d1c71e71b06e248c8dbe94d49ef6d6b0d64f5d71b1e33a0f39e14dadb070304a"
+)
+
+func TestValidateAddress(t *testing.T) {
+	var (
+		lenient = newValidatePolicy()
+		strict  = newValidatePolicy(WithChecksum())
+	)
+
+	require.NoError(t, lenient.validateAddress(TestChecksummed))
+	require.NoError(t, lenient.validateAddress(strings.ToLower(TestChecksummed)))
+	require.NoError(t, strict.validateAddress(TestChecksummed))
+
+	// 大文字小文字が混在する場合はチェックサムを検証する
+	require.ErrorIs(t, lenient.validateAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"), ErrChecksum)
+	require.ErrorIs(t, strict.validateAddress(strings.ToLower(TestChecksummed)), ErrChecksum)
+
+	for _, zero := range []string{
+		"0x0000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000",
+		"0X0000000000000000000000000000000000000000",
+	} {
+		require.ErrorIs(t, lenient.validateAddress(zero), ErrZeroAddress, zero)
+	}
+	require.ErrorIs(t, lenient.validateAddress("0x1234"), ErrInvalidAddress)
+
+	burn := newValidatePolicy(WithDeniedBurnAddresses(), WithDeniedAddresses(TestChecksummed))
+	require.ErrorIs(t, burn.validateAddress(strings.ToLower(BurnAddresses[0])), ErrDeniedAddress)
+	require.ErrorIs(t, burn.validateAddress(TestChecksummed), ErrDeniedAddress)
+	require.NoError(t, burn.validateAddress(TestContract))
+}
+
+func TestValidatePolicy(t *testing.T) {
+	req := TransferRequest{
+		PrivateKey:      TestPrivateKey,
+		ContractAddress: TestContract,
+		Recipient:       strings.ToLower(TestContract),
+		Amount:          "1",
+	}
+	require.NoError(t, req.Validate())
+
+	// トークンコントラクト自身への移転は拒否できる
+	err := req.Validate(WithDeniedContractRecipient())
+	require.ErrorIs(t, err, ErrDeniedAddress)
+	require.Contains(t, err.Error(), "recipient")
+
+	err = req.Validate(WithChecksum())
+	require.ErrorIs(t, err, ErrChecksum)
+	require.Contains(t, err.Error(), "recipient")
+}
+
+func TestValidationError(t *testing.T) {
+	req := CreateContractsRequest{
+		ContractAddress: "0x1234",
+		Symbol:          "TKN",
+		InitialSupply:   "-1",
+		Grantees:        []string{TestChecksummed, "0x0000000000000000000000000000000000000000"},
+	}
+
+	// 全てのフィールドのエラーを返す
+	err := req.Validate()
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	require.Equal(t, []string{"private_key", "contract_address", "name", "initialSupply", "grantees[1]"}, fieldNames(verr))
+
+	codes := make(map[string]string)
+	for _, f := range verr.Fields {
+		codes[f.Field] = f.Code
+	}
+	require.Equal(t, map[string]string{
+		"private_key":      CodeRequired,
+		"contract_address": CodeInvalidAddress,
+		"name":             CodeRequired,
+		"initialSupply":    CodeInvalidAmount,
+		"grantees[1]":      CodeZeroAddress,
+	}, codes)
+	require.ErrorIs(t, err, ErrZeroAddress)
+
+	// 秘密鍵はメッセージに含めない
+	dreq := DeployCSRequest{PrivateKey: "not a key"}
+	err = dreq.Validate()
+	require.ErrorAs(t, err, &verr)
+	f, ok := verr.Field("private_key")
+	require.True(t, ok)
+	require.Equal(t, CodeInvalidPrivateKey, f.Code)
+	require.NotContains(t, err.Error(), dreq.PrivateKey)
+
+	dreq.PrivateKey = TestPrivateKey
+	require.NoError(t, dreq.Validate())
+
+	greq := GrantRoleRequest{PrivateKey: TestPrivateKey, ContractAddress: TestContract, Role: "00", Grantee: TestContract}
+	require.ErrorIs(t, greq.Validate(), ErrInvalidRole)
+	greq.Role = ST_CONTROL_ROLE
+	require.NoError(t, greq.Validate())
+
+	// 前回のハッシュは任意
+	oreq := OnboardInvestorRequest{PrivateKey: TestPrivateKey, ContractAddress: TestContract, Account: TestChecksummed, Amount: "10"}
+	require.NoError(t, oreq.Validate())
+	oreq.IssueHash = "0x1234"
+	require.ErrorIs(t, oreq.Validate(), ErrInvalidTxHash)
+	oreq.IssueHash = "0x" + ST_CONTROL_ROLE
+	require.NoError(t, oreq.Validate())
+}
+
+func fieldNames(e *ValidationError) (names []string) {
+	for _, f := range e.Fields {
+		names = append(names, f.Field)
+	}
+	return
+}
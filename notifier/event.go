@@ -0,0 +1,156 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ango-ya/chain-client/contract"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnknownEvent = errors.New("unknown event")
+)
+
+// LogSource is satisfied by client.BlockchainClient.
+type LogSource interface {
+	LatestBlockNumber(ctx context.Context) (uint64, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// Watch polls the logs of SecurityToken and ComplianceService contracts from the block next to the latest one,
+// and notifies every decoded event. It blocks until the context is canceled.
+func (n *Notifier) Watch(ctx context.Context, source LogSource, contracts ...common.Address) error {
+	latest, err := source.LatestBlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the latest block number")
+	}
+
+	return n.WatchFrom(ctx, source, latest+1, contracts...)
+}
+
+// WatchFrom is the same as Watch, starting from the given block.
+func (n *Notifier) WatchFrom(ctx context.Context, source LogSource, from uint64, contracts ...common.Address) error {
+	ticker := time.NewTicker(n.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		latest, err := source.LatestBlockNumber(ctx)
+		if err != nil {
+			n.logger.Warn().Err(err).Msg("failed to get the latest block number")
+			continue
+		}
+		if latest < from {
+			continue
+		}
+
+		logs, err := source.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(latest),
+			Addresses: contracts,
+		})
+		if err != nil {
+			n.logger.Warn().Err(err).Msgf("failed to filter logs, from=%d, to=%d", from, latest)
+			continue
+		}
+
+		for _, log := range logs {
+			p, err := n.EventPayload(log)
+			if err != nil {
+				n.logger.Debug().Err(err).Msgf("skip log, hash=%s, index=%d", log.TxHash.String(), log.Index)
+				continue
+			}
+			if err = n.Notify(ctx, p); err != nil {
+				return nil
+			}
+		}
+
+		from = latest + 1
+	}
+}
+
+// EventPayload decodes a SecurityToken or ComplianceService log into a payload.
+func (n *Notifier) EventPayload(log types.Log) (p Payload, err error) {
+	if len(log.Topics) == 0 || log.Removed {
+		err = errors.Wrap(ErrUnknownEvent, "anonymous or removed log")
+		return
+	}
+
+	var (
+		contractABI *contract.ParsedABI
+		event       *abi.Event
+	)
+	for _, a := range []*contract.ParsedABI{n.stABI, n.csABI} {
+		if event, err = a.EventByID(log.Topics[0]); err == nil {
+			contractABI = a
+			break
+		}
+	}
+	if event == nil {
+		err = errors.Wrapf(ErrUnknownEvent, "topic=%s", log.Topics[0].String())
+		return
+	}
+
+	args := make(map[string]interface{})
+	if err = contractABI.UnpackIntoMap(args, event.Name, log.Data); err != nil {
+		err = errors.Wrapf(err, "failed to unpack event(=%s)", event.Name)
+		return
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err = abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+		err = errors.Wrapf(err, "failed to parse topics of event(=%s)", event.Name)
+		return
+	}
+
+	for k, v := range args {
+		args[k] = jsonValue(v)
+	}
+
+	p = Payload{
+		ID:          fmt.Sprintf("%s-%d", log.TxHash.String(), log.Index),
+		Type:        PayloadEvent,
+		Event:       event.Name,
+		Contract:    log.Address.String(),
+		Hash:        log.TxHash.String(),
+		BlockNumber: log.BlockNumber,
+		Args:        args,
+	}
+	return
+}
+
+// jsonValue converts ABI values into their usual JSON representation. Amounts become decimal strings.
+func jsonValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case *big.Int:
+		return t.String()
+	case common.Address:
+		return t.String()
+	case common.Hash:
+		return t.String()
+	case [32]byte:
+		return hexutil.Encode(t[:])
+	case []byte:
+		return hexutil.Encode(t)
+	default:
+		return t
+	}
+}
@@ -0,0 +1,346 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ango-ya/chain-client/client"
+	"github.com/ango-ya/chain-client/contract"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	SignatureHeader  = "X-Signature"
+	DeliveryIDHeader = "X-Delivery-Id"
+
+	signaturePrefix = "sha256="
+)
+
+var (
+	ErrNonRetryable = errors.New("non retryable response")
+	ErrQueueFull    = errors.New("queue full")
+	ErrClosed       = errors.New("notifier closed")
+)
+
+type PayloadType string
+
+const (
+	PayloadEvent PayloadType = "event"
+	PayloadTx    PayloadType = "tx"
+)
+
+// Payload is the JSON body posted to the webhooks.
+type Payload struct {
+	ID          string                 `json:"id"`
+	Type        PayloadType            `json:"type"`
+	Event       string                 `json:"event,omitempty"`
+	Contract    string                 `json:"contract,omitempty"`
+	Hash        string                 `json:"hash"`
+	BlockNumber uint64                 `json:"block_number,omitempty"`
+	Args        map[string]interface{} `json:"args,omitempty"`
+	Status      string                 `json:"status,omitempty"`
+	GasUsed     uint64                 `json:"gas_used,omitempty"`
+	Error       string                 `json:"error,omitempty"`
+	CreatedAt   int64                  `json:"created_at"`
+}
+
+type deadLetter struct {
+	URL      string  `json:"url"`
+	Payload  Payload `json:"payload"`
+	Error    string  `json:"error"`
+	FailedAt int64   `json:"failed_at"`
+}
+
+// Notifier posts token lifecycle events and async transaction outcomes to webhooks.
+// Payloads are signed with HMAC-SHA256 over the body, sent in the X-Signature header.
+type Notifier struct {
+	urls   []string
+	secret []byte
+
+	httpClient *http.Client
+	maxRetries int
+	backoff    time.Duration
+	maxBackoff time.Duration
+
+	deadLetter string
+	dlMu       sync.Mutex
+
+	queue        chan Payload
+	pollInterval time.Duration
+	done         chan struct{}
+
+	// mu guards closed against the senders, stopping unblocks them once closing
+	mu        sync.RWMutex
+	started   bool
+	closed    bool
+	closeOnce sync.Once
+	stopping  chan struct{}
+
+	// ctx is canceled when Shutdown gives up waiting, interrupting the deliveries
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	stABI *contract.ParsedABI
+	csABI *contract.ParsedABI
+
+	logger zerolog.Logger
+}
+
+func NewNotifier(urls []string, secret []byte, opts ...Option) (n *Notifier, err error) {
+	if len(urls) == 0 {
+		err = errors.New("no webhook url")
+		return
+	}
+
+	n = &Notifier{
+		urls:         urls,
+		secret:       secret,
+		httpClient:   &http.Client{Timeout: time.Duration(DefaultTimeout) * time.Second},
+		maxRetries:   DefaultMaxRetries,
+		backoff:      time.Duration(DefaultBackoff) * time.Millisecond,
+		maxBackoff:   time.Duration(DefaultMaxBackoff) * time.Millisecond,
+		queue:        make(chan Payload, DefaultQueueSize),
+		pollInterval: time.Duration(DefaultPollInterval) * time.Second,
+		done:         make(chan struct{}),
+		stopping:     make(chan struct{}),
+		logger:       DefaultLogger,
+	}
+	n.ctx, n.cancel = context.WithCancel(context.Background())
+
+	if n.stABI, err = contract.ParsedSecurityTokenABI(); err != nil {
+		return
+	}
+
+	if n.csABI, err = contract.ParsedComplianceServiceABI(); err != nil {
+		return
+	}
+
+	for i := range opts {
+		opts[i].Apply(n)
+	}
+	return
+}
+
+// Start launches the delivery worker.
+func (n *Notifier) Start() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.started || n.closed {
+		return
+	}
+	n.started = true
+	go n.work()
+}
+
+func (n *Notifier) work() {
+	defer close(n.done)
+
+	for p := range n.queue {
+		n.deliver(p)
+	}
+}
+
+// Close stops accepting payloads and waits until the queued ones are delivered or dead-lettered.
+func (n *Notifier) Close() {
+	_ = n.Shutdown(context.Background())
+}
+
+// Shutdown is Close giving up waiting when ctx is done: the pending retries are interrupted and the remaining payloads dead-lettered.
+// The payloads queued before Start are delivered too.
+func (n *Notifier) Shutdown(ctx context.Context) error {
+	n.closeOnce.Do(func() {
+		close(n.stopping)
+
+		n.mu.Lock()
+		defer n.mu.Unlock()
+
+		n.closed = true
+		close(n.queue)
+		if !n.started {
+			n.started = true
+			go n.work()
+		}
+	})
+
+	defer n.cancel()
+
+	select {
+	case <-n.done:
+		return nil
+	case <-ctx.Done():
+		n.cancel()
+		<-n.done
+		return ctx.Err()
+	}
+}
+
+// Notify queues the payload for delivery.
+func (n *Notifier) Notify(ctx context.Context, p Payload) error {
+	if p.CreatedAt == 0 {
+		p.CreatedAt = time.Now().Unix()
+	}
+
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	if n.closed {
+		return ErrClosed
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-n.stopping:
+		return ErrClosed
+	case n.queue <- p:
+		return nil
+	}
+}
+
+// HandleTxResult is meant to be registered with client.WithTxResultHandler.
+// It never blocks the tracker, payloads are dead-lettered when the queue is full or the notifier closed.
+func (n *Notifier) HandleTxResult(r client.TxResult) {
+	p := Payload{
+		ID:          r.Hash,
+		Type:        PayloadTx,
+		Hash:        r.Hash,
+		BlockNumber: r.BlockNumber,
+		Status:      string(r.Status),
+		GasUsed:     r.GasUsed,
+		CreatedAt:   r.DoneAt.Unix(),
+	}
+	if r.Err != nil {
+		p.Error = r.Err.Error()
+	}
+
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	cause := ErrClosed
+	if !n.closed {
+		select {
+		case n.queue <- p:
+			return
+		default:
+			cause = ErrQueueFull
+		}
+	}
+
+	for _, url := range n.urls {
+		n.writeDeadLetter(url, p, cause)
+	}
+}
+
+func (n *Notifier) deliver(p Payload) {
+	body, err := json.Marshal(p)
+	if err != nil {
+		n.logger.Error().Err(err).Msgf("failed to marshal payload(=%s)", p.ID)
+		return
+	}
+
+	for _, url := range n.urls {
+		if err := n.post(url, p.ID, body); err != nil {
+			n.logger.Warn().Err(err).Msgf("failed to notify, url=%s, id=%s", url, p.ID)
+			n.writeDeadLetter(url, p, err)
+		}
+	}
+}
+
+// post sends the body, retrying with an exponential backoff on network errors and retryable statuses.
+func (n *Notifier) post(url, id string, body []byte) (err error) {
+	backoff := n.backoff
+	for i := 0; i <= n.maxRetries; i++ {
+		if i > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-n.ctx.Done():
+				timer.Stop()
+				return errors.Wrapf(ErrClosed, "gave up after %d attempts: %s", i, err.Error())
+			case <-timer.C:
+			}
+			if backoff *= 2; backoff > n.maxBackoff {
+				backoff = n.maxBackoff
+			}
+		}
+
+		if err = n.postOnce(url, id, body); err == nil || errors.Is(err, ErrNonRetryable) {
+			return
+		}
+	}
+	return errors.Wrapf(err, "gave up after %d retries", n.maxRetries)
+}
+
+func (n *Notifier) postOnce(url, id string, body []byte) error {
+	req, err := http.NewRequestWithContext(n.ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(ErrNonRetryable, err.Error())
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(n.secret, body))
+	req.Header.Set(DeliveryIDHeader, id)
+
+	res, err := n.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post")
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return nil
+	case res.StatusCode == http.StatusRequestTimeout, res.StatusCode == http.StatusTooManyRequests, res.StatusCode >= 500:
+		return errors.Errorf("unexpected status(=%d)", res.StatusCode)
+	default:
+		return errors.Wrapf(ErrNonRetryable, "status=%d", res.StatusCode)
+	}
+}
+
+func (n *Notifier) writeDeadLetter(url string, p Payload, cause error) {
+	if n.deadLetter == "" {
+		return
+	}
+
+	line, err := json.Marshal(deadLetter{URL: url, Payload: p, Error: cause.Error(), FailedAt: time.Now().Unix()})
+	if err != nil {
+		n.logger.Error().Err(err).Msgf("failed to marshal dead letter(=%s)", p.ID)
+		return
+	}
+
+	n.dlMu.Lock()
+	defer n.dlMu.Unlock()
+
+	f, err := os.OpenFile(n.deadLetter, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		n.logger.Error().Err(err).Msgf("failed to open dead letter file(=%s)", n.deadLetter)
+		return
+	}
+	defer f.Close()
+
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		n.logger.Error().Err(err).Msgf("failed to write dead letter(=%s)", p.ID)
+	}
+}
+
+// Sign computes the value of the X-Signature header for the body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the X-Signature header on the receiver side.
+func Verify(secret, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
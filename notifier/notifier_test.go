@@ -0,0 +1,230 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ango-ya/chain-client/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	TestSecret    = []byte("test secret")
+	TestRecipient = common.HexToAddress("0x31a6EE302c1E7602685c86EF7a3069210Bc26670")
+	TestIssuer    = common.HexToAddress("0x26fa9f1a6568b42e29b1787c403B3628dFC0C6FE")
+	TestToken     = common.HexToAddress("0xA7E7717817776181f64b46f9e4EFC75e181f9Dce")
+)
+
+func newTestServer(t *testing.T, statuses ...int) (*httptest.Server, chan Payload, *int32) {
+	var (
+		received = make(chan Payload, 16)
+		calls    int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := atomic.AddInt32(&calls, 1) - 1
+		if int(i) < len(statuses) {
+			w.WriteHeader(statuses[i])
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.True(t, Verify(TestSecret, body, r.Header.Get(SignatureHeader)))
+
+		var p Payload
+		require.NoError(t, json.Unmarshal(body, &p))
+		require.Equal(t, p.ID, r.Header.Get(DeliveryIDHeader))
+		received <- p
+	}))
+	t.Cleanup(srv.Close)
+	return srv, received, &calls
+}
+
+func readDeadLetters(t *testing.T, path string) (letters []deadLetter) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return
+	}
+	require.NoError(t, err)
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var l deadLetter
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &l))
+		letters = append(letters, l)
+	}
+	return
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	sig := Sign(TestSecret, body)
+
+	require.True(t, Verify(TestSecret, body, sig))
+	require.False(t, Verify([]byte("other secret"), body, sig))
+	require.False(t, Verify(TestSecret, []byte(`{"id":"2"}`), sig))
+}
+
+func TestNotify(t *testing.T) {
+	var (
+		srv, received, calls = newTestServer(t, http.StatusInternalServerError, http.StatusTooManyRequests)
+		deadLetter           = filepath.Join(t.TempDir(), "dead.jsonl")
+	)
+	n, err := NewNotifier([]string{srv.URL}, TestSecret, WithBackoff(1, 4), WithDeadLetter(deadLetter))
+	require.NoError(t, err)
+	n.Start()
+
+	// 2回失敗した後に配信される
+	p := Payload{ID: "0x01-0", Type: PayloadEvent, Event: "Issued", Hash: "0x01"}
+	require.NoError(t, n.Notify(context.Background(), p))
+	n.Close()
+
+	got := <-received
+	require.Equal(t, p.ID, got.ID)
+	require.Equal(t, p.Event, got.Event)
+	require.NotZero(t, got.CreatedAt)
+	require.Equal(t, int32(3), atomic.LoadInt32(calls))
+	require.Empty(t, readDeadLetters(t, deadLetter))
+}
+
+func TestDeadLetter(t *testing.T) {
+	var (
+		failing, _, failingCalls = newTestServer(t, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+		rejecting, _, rejectCall = newTestServer(t, http.StatusBadRequest)
+		deadLetter               = filepath.Join(t.TempDir(), "dead.jsonl")
+	)
+	n, err := NewNotifier([]string{failing.URL, rejecting.URL}, TestSecret, WithMaxRetries(2), WithBackoff(1, 4), WithDeadLetter(deadLetter))
+	require.NoError(t, err)
+	n.Start()
+
+	p := Payload{ID: "0x02", Type: PayloadTx, Hash: "0x02", Status: string(client.TxConfirmed)}
+	require.NoError(t, n.Notify(context.Background(), p))
+	n.Close()
+
+	// リトライ上限まで試行し、4xxはリトライしない
+	require.Equal(t, int32(3), atomic.LoadInt32(failingCalls))
+	require.Equal(t, int32(1), atomic.LoadInt32(rejectCall))
+
+	letters := readDeadLetters(t, deadLetter)
+	require.Len(t, letters, 2)
+	require.Equal(t, failing.URL, letters[0].URL)
+	require.Equal(t, rejecting.URL, letters[1].URL)
+	require.Equal(t, p.ID, letters[1].Payload.ID)
+	require.NotEmpty(t, letters[1].Error)
+}
+
+func TestHandleTxResult(t *testing.T) {
+	srv, received, _ := newTestServer(t)
+	n, err := NewNotifier([]string{srv.URL}, TestSecret)
+	require.NoError(t, err)
+	n.Start()
+
+	n.HandleTxResult(client.TxResult{
+		Hash:        "0x03",
+		Status:      client.TxReverted,
+		BlockNumber: 10,
+		GasUsed:     21000,
+		DoneAt:      time.Now(),
+		Err:         client.ErrTxReverted,
+	})
+	n.Close()
+
+	got := <-received
+	require.Equal(t, PayloadTx, got.Type)
+	require.Equal(t, "0x03", got.Hash)
+	require.Equal(t, string(client.TxReverted), got.Status)
+	require.Equal(t, uint64(10), got.BlockNumber)
+	require.Equal(t, client.ErrTxReverted.Error(), got.Error)
+}
+
+func TestClose(t *testing.T) {
+	var (
+		srv, received, _ = newTestServer(t)
+		deadLetter       = filepath.Join(t.TempDir(), "dead.jsonl")
+	)
+	n, err := NewNotifier([]string{srv.URL}, TestSecret, WithDeadLetter(deadLetter))
+	require.NoError(t, err)
+
+	// Startしていなくても閉じられ、キューは配信される
+	require.NoError(t, n.Notify(context.Background(), Payload{ID: "0x05", Type: PayloadTx, Hash: "0x05"}))
+	n.Close()
+	require.Equal(t, "0x05", (<-received).ID)
+
+	// 閉じた後の送信はpanicしない
+	require.ErrorIs(t, n.Notify(context.Background(), Payload{ID: "0x06"}), ErrClosed)
+	n.HandleTxResult(client.TxResult{Hash: "0x07", Status: client.TxConfirmed, DoneAt: time.Now()})
+	n.Close()
+
+	letters := readDeadLetters(t, deadLetter)
+	require.Len(t, letters, 1)
+	require.Equal(t, "0x07", letters[0].Payload.ID)
+	require.Contains(t, letters[0].Error, ErrClosed.Error())
+}
+
+func TestShutdown(t *testing.T) {
+	var (
+		srv, _, calls = newTestServer(t, http.StatusBadGateway, http.StatusBadGateway)
+		deadLetter    = filepath.Join(t.TempDir(), "dead.jsonl")
+	)
+	n, err := NewNotifier([]string{srv.URL}, TestSecret, WithBackoff(60000, 60000), WithDeadLetter(deadLetter))
+	require.NoError(t, err)
+	n.Start()
+
+	require.NoError(t, n.Notify(context.Background(), Payload{ID: "0x08", Type: PayloadTx, Hash: "0x08"}))
+
+	// バックオフ中でも待たずに終了し、デッドレターに残す
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	require.ErrorIs(t, n.Shutdown(ctx), context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.Equal(t, int32(1), atomic.LoadInt32(calls))
+
+	letters := readDeadLetters(t, deadLetter)
+	require.Len(t, letters, 1)
+	require.Contains(t, letters[0].Error, ErrClosed.Error())
+}
+
+func TestEventPayload(t *testing.T) {
+	n, err := NewNotifier([]string{"http://localhost"}, TestSecret)
+	require.NoError(t, err)
+
+	var (
+		event  = n.stABI.Events["Issued"]
+		amount = big.NewInt(100)
+	)
+	payload, err := event.Inputs.NonIndexed().Pack(TestIssuer, amount)
+	require.NoError(t, err)
+
+	p, err := n.EventPayload(types.Log{
+		Address:     TestToken,
+		Topics:      []common.Hash{event.ID, common.BytesToHash(TestRecipient.Bytes())},
+		Data:        payload,
+		BlockNumber: 5,
+		TxHash:      common.HexToHash("0x04"),
+		Index:       1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Issued", p.Event)
+	require.Equal(t, TestToken.String(), p.Contract)
+	require.Equal(t, uint64(5), p.BlockNumber)
+	require.Equal(t, TestIssuer.String(), p.Args["issuer"])
+	require.Equal(t, TestRecipient.String(), p.Args["recipient"])
+	require.Equal(t, amount.String(), p.Args["amount"])
+
+	_, err = n.EventPayload(types.Log{Topics: []common.Hash{common.HexToHash("0x05")}})
+	require.ErrorIs(t, err, ErrUnknownEvent)
+}
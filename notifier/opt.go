@@ -0,0 +1,119 @@
+package notifier
+
+import (
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	DefaultMaxRetries   = 5
+	DefaultBackoff      = int64(500)       // 500 ms
+	DefaultMaxBackoff   = int64(30 * 1000) // 30 sec
+	DefaultTimeout      = int64(10)        // 10 sec
+	DefaultQueueSize    = 1024
+	DefaultPollInterval = int64(3) // 3 sec
+)
+
+var DefaultLogger = zerolog.New(os.Stderr).Level(zerolog.InfoLevel).With().Timestamp().Logger()
+
+type Option interface {
+	Apply(*Notifier)
+}
+
+type MaxRetriesOpt int
+
+func (o MaxRetriesOpt) Apply(n *Notifier) {
+	n.maxRetries = int(o)
+}
+func WithMaxRetries(r int) MaxRetriesOpt {
+	if r < 0 {
+		panic("MaxRetries should not be negative")
+	}
+	return MaxRetriesOpt(r)
+}
+
+type BackoffOpt [2]int64
+
+func (o BackoffOpt) Apply(n *Notifier) {
+	n.backoff = time.Duration(o[0]) * time.Millisecond
+	n.maxBackoff = time.Duration(o[1]) * time.Millisecond
+}
+
+// WithBackoff sets the delay before the first retry and its upper bound, in milliseconds.
+// The delay doubles on every retry.
+func WithBackoff(initial, max int64) BackoffOpt {
+	if initial <= 0 || max < initial {
+		panic("Backoff should be positive and not exceed the max")
+	}
+	return BackoffOpt{initial, max}
+}
+
+type TimeoutOpt int64
+
+func (o TimeoutOpt) Apply(n *Notifier) {
+	n.httpClient.Timeout = time.Duration(o) * time.Second
+}
+func WithTimeout(t int64) TimeoutOpt {
+	if t <= 0 {
+		panic("Timeout should be positive")
+	}
+	return TimeoutOpt(t)
+}
+
+type DeadLetterOpt string
+
+func (o DeadLetterOpt) Apply(n *Notifier) {
+	n.deadLetter = string(o)
+}
+
+// WithDeadLetter sets the file where payloads that could not be delivered are appended as JSON lines.
+func WithDeadLetter(path string) DeadLetterOpt {
+	return DeadLetterOpt(path)
+}
+
+type QueueSizeOpt int
+
+func (o QueueSizeOpt) Apply(n *Notifier) {
+	n.queue = make(chan Payload, int(o))
+}
+func WithQueueSize(size int) QueueSizeOpt {
+	if size <= 0 {
+		panic("QueueSize should be positive")
+	}
+	return QueueSizeOpt(size)
+}
+
+type PollIntervalOpt int64
+
+func (o PollIntervalOpt) Apply(n *Notifier) {
+	n.pollInterval = time.Duration(o) * time.Second
+}
+func WithPollInterval(i int64) PollIntervalOpt {
+	if i <= 0 {
+		panic("PollInterval should be positive")
+	}
+	return PollIntervalOpt(i)
+}
+
+type HTTPClientOpt struct {
+	client *http.Client
+}
+
+func (o HTTPClientOpt) Apply(n *Notifier) {
+	n.httpClient = o.client
+}
+func WithHTTPClient(client *http.Client) HTTPClientOpt {
+	return HTTPClientOpt{client}
+}
+
+type LoggerOpt zerolog.Logger
+
+func (o LoggerOpt) Apply(n *Notifier) {
+	n.logger = zerolog.Logger(o)
+}
+func WithLoggerOpt(logger zerolog.Logger) LoggerOpt {
+	return LoggerOpt(logger)
+}